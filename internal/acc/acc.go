@@ -19,6 +19,7 @@ import (
 
 	"terraform-provider-ytsaurus/internal/provider"
 	"terraform-provider-ytsaurus/internal/set"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
 )
 
 const (
@@ -118,6 +119,26 @@ func accCheckYTsaurusListAttribute(objectCypressPath, attributeName string, valu
 	}
 }
 
+func accCheckYTsaurusNodeValue(objectCypressPath, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		p := ypath.Path(objectCypressPath)
+		var result interface{}
+		if err := testYTClient.GetNode(ctx, p, &result, nil); err != nil {
+			return err
+		}
+
+		encoded, err := ytsaurus.MarshalValue(result)
+		if err != nil {
+			return err
+		}
+
+		if !ytsaurus.IsEqualValues(encoded, value) {
+			return fmt.Errorf("YTsaurus %q expected %s, got %s", p.String(), value, encoded)
+		}
+		return nil
+	}
+}
+
 func accCheckYTsaurusUserMemberOfAttribute(objectCypressPath string, value []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		p := ypath.Path(objectCypressPath).Attr("member_of")
@@ -136,6 +157,24 @@ func accCheckYTsaurusUserMemberOfAttribute(objectCypressPath string, value []str
 	}
 }
 
+func accCheckYTsaurusGroupMembers(objectCypressPath string, value []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		p := ypath.Path(objectCypressPath).Attr("members")
+		var result []string
+		if err := testYTClient.GetNode(ctx, p, &result, nil); err != nil {
+			return err
+		}
+		resultSet := set.ToStringSet(result)
+		valueSet := set.ToStringSet(value)
+
+		if len(valueSet.Difference(resultSet)) > 0 || len(resultSet.Difference(valueSet)) > 0 {
+			return fmt.Errorf("YTsaurus %q expected %q, got %q", p.String(), valueSet, resultSet)
+		}
+
+		return nil
+	}
+}
+
 type nodeDynConfigTabletNode struct {
 	TabletDynamicMemory int64 `yson:"tablet_dynamic_memory"`
 	TabletStaticMemory  int64 `yson:"tablet_static_memory"`
@@ -163,8 +202,16 @@ func accDynConfigReconfigureSlotsOnTabletNodes() {
 }
 
 func accCheckYTsaurusACLAttribute(objectCypressPath string, value []yt.ACE) resource.TestCheckFunc {
+	return accCheckYTsaurusACEListAttribute(objectCypressPath, "acl", value)
+}
+
+func accCheckYTsaurusPrincipalACLAttribute(objectCypressPath string, value []yt.ACE) resource.TestCheckFunc {
+	return accCheckYTsaurusACEListAttribute(objectCypressPath, "principal_acl", value)
+}
+
+func accCheckYTsaurusACEListAttribute(objectCypressPath, attr string, value []yt.ACE) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		p := ypath.Path(objectCypressPath).Attr("acl")
+		p := ypath.Path(objectCypressPath).Attr(attr)
 		var result []yt.ACE
 		if err := testYTClient.GetNode(ctx, p, &result, nil); err != nil {
 			return err
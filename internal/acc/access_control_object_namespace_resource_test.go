@@ -0,0 +1,87 @@
+package acc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/accesscontrolobjectnamespace"
+	"terraform-provider-ytsaurus/internal/resource/acl"
+)
+
+func TestAccessControlObjectNamespaceResourceACL(t *testing.T) {
+	resourceID := "fakeaconamespace_acl"
+	testNamespaceName := resourceID
+	testNamespaceYTCypressPath := fmt.Sprintf("//sys/access_control_object_namespaces/%s", testNamespaceName)
+
+	testACL := []yt.ACE{
+		{
+			Action:      yt.ActionAllow,
+			Subjects:    []string{"users"},
+			Permissions: []string{yt.PermissionRead},
+		},
+	}
+
+	testACLUpdated := []yt.ACE{
+		{
+			Action:      yt.ActionAllow,
+			Subjects:    []string{"users"},
+			Permissions: []string{yt.PermissionRead, yt.PermissionWrite},
+		},
+	}
+
+	configCreate := accesscontrolobjectnamespace.AccessControlObjectNamespaceModel{
+		Name: types.StringValue(testNamespaceName),
+		ACL:  acl.ToACLModel(testACL),
+	}
+
+	configUpdate := accesscontrolobjectnamespace.AccessControlObjectNamespaceModel{
+		Name: types.StringValue(testNamespaceName),
+		ACL:  acl.ToACLModel(testACLUpdated),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testNamespaceYTCypressPath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusAccessControlObjectNamespaceConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testNamespaceYTCypressPath, "name", testNamespaceName),
+					accCheckYTsaurusACLAttribute(testNamespaceYTCypressPath, testACL),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusAccessControlObjectNamespaceConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusACLAttribute(testNamespaceYTCypressPath, testACLUpdated),
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_access_control_object_namespace.%s", resourceID), "acl.#", "1"),
+				),
+			},
+			{
+				ResourceName:      fmt.Sprintf("ytsaurus_access_control_object_namespace.%s", resourceID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusAccessControlObjectNamespaceConfig(id string, m accesscontrolobjectnamespace.AccessControlObjectNamespaceModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_access_control_object_namespace" %q {
+		name = %q`, id, m.Name.ValueString())
+
+	namespaceACL, _ := acl.ToYTsaurusACL(m.ACL)
+	if len(namespaceACL) > 0 {
+		config += accAddACLConfig(namespaceACL)
+	}
+
+	config += `
+	}`
+
+	return config
+}
@@ -0,0 +1,93 @@
+package acc
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/accesscontrolobject"
+	"terraform-provider-ytsaurus/internal/resource/acl"
+)
+
+func TestAccessControlObjectResourceCreateAndUpdate(t *testing.T) {
+	namespaceResourceID := "fakeaconamespace"
+	resourceID := "fakeaco"
+	testNamespaceName := namespaceResourceID
+	testObjectName := resourceID
+	testNamespaceYTCypressPath := fmt.Sprintf("//sys/access_control_object_namespaces/%s", testNamespaceName)
+	testObjectYTCypressPath := fmt.Sprintf("%s/%s", testNamespaceYTCypressPath, testObjectName)
+
+	testPrincipalACL := []yt.ACE{
+		{
+			Action:      yt.ActionAllow,
+			Subjects:    []string{"users"},
+			Permissions: []string{yt.PermissionUse},
+		},
+	}
+
+	testPrincipalACLUpdated := []yt.ACE{
+		{
+			Action:      yt.ActionAllow,
+			Subjects:    []string{"users"},
+			Permissions: []string{yt.PermissionRead, yt.PermissionUse},
+		},
+	}
+
+	configCreate := accesscontrolobject.AccessControlObjectModel{
+		Name:         types.StringValue(testObjectName),
+		PrincipalACL: acl.ToACLModel(testPrincipalACL),
+	}
+
+	configUpdate := accesscontrolobject.AccessControlObjectModel{
+		Name:         types.StringValue(testObjectName),
+		PrincipalACL: acl.ToACLModel(testPrincipalACLUpdated),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testNamespaceYTCypressPath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusAccessControlObjectConfig(namespaceResourceID, resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testNamespaceYTCypressPath, "name", testNamespaceName),
+					accCheckYTsaurusStringAttribute(testObjectYTCypressPath, "namespace", testNamespaceName),
+					accCheckYTsaurusStringAttribute(testObjectYTCypressPath, "type", string(yt.NodeAccessControlObject)),
+					accCheckYTsaurusPrincipalACLAttribute(testObjectYTCypressPath, testPrincipalACL),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusAccessControlObjectConfig(namespaceResourceID, resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusPrincipalACLAttribute(testObjectYTCypressPath, testPrincipalACLUpdated),
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_access_control_object.%s", resourceID), "principal_acl.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusAccessControlObjectConfig(namespaceID, id string, m accesscontrolobject.AccessControlObjectModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_access_control_object_namespace" %q {
+		name = %q
+	}
+
+	resource "ytsaurus_access_control_object" %q {
+		name      = %q
+		namespace = ytsaurus_access_control_object_namespace.%s.name`, namespaceID, namespaceID, id, m.Name.ValueString(), namespaceID)
+
+	principalACL, _ := acl.ToYTsaurusACL(m.PrincipalACL)
+	if len(principalACL) > 0 {
+		config += strings.Replace(accAddACLConfig(principalACL), "acl =", "principal_acl =", 1)
+	}
+
+	config += `
+	}`
+
+	return config
+}
@@ -3,12 +3,14 @@ package acc
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/ypath"
 	"go.ytsaurus.tech/yt/go/yt"
 
 	"terraform-provider-ytsaurus/internal/resource/account"
@@ -197,6 +199,75 @@ func TestAccountResourceCreateWithAllOptions(t *testing.T) {
 	})
 }
 
+func TestAccountResourceMasterMemoryLimits(t *testing.T) {
+
+	resourceID := "testaccount"
+	testAccountName := resourceID
+	testAccountYTCypressPath := fmt.Sprintf("//sys/accounts/%s", testAccountName)
+
+	var primaryCellTag int64
+	if err := testYTClient.GetNode(ctx, ypath.Path("//sys/@primary_cell_tag"), &primaryCellTag, nil); err != nil {
+		t.Fatal(err)
+	}
+	testCellTag := strconv.FormatInt(primaryCellTag, 10)
+
+	testMasterMemory := int64(100000000)
+	testChunkHostMasterMemory := int64(50000000)
+	testCellMasterMemory := int64(10000000)
+
+	resourceLimits := func(masterMemory int64) *account.AccountResourceLimitsModel {
+		return &account.AccountResourceLimitsModel{
+			ChunkCount: types.Int64Value(1000),
+			NodeCount:  types.Int64Value(1000),
+			DiskSpacePerMedium: map[string]basetypes.Int64Value{
+				"default": types.Int64Value(1000000),
+			},
+			MasterMemory: &account.AccountMasterMemoryLimitsModel{
+				Total:     types.Int64Value(masterMemory),
+				ChunkHost: types.Int64Value(testChunkHostMasterMemory),
+				PerCell: map[string]basetypes.Int64Value{
+					testCellTag: types.Int64Value(testCellMasterMemory),
+				},
+			},
+			ChunkHostCellMasterMemory: map[string]basetypes.Int64Value{
+				testCellTag: types.Int64Value(testCellMasterMemory),
+			},
+		}
+	}
+
+	configCreate := account.AccountModel{
+		Name:           types.StringValue(testAccountName),
+		ResourceLimits: resourceLimits(testMasterMemory),
+	}
+
+	configUpdate := account.AccountModel{
+		Name:           types.StringValue(testAccountName),
+		ResourceLimits: resourceLimits(testMasterMemory * 2),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testAccountYTCypressPath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusAccountConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute(testAccountYTCypressPath, "resource_limits/master_memory/total", testMasterMemory),
+					accCheckYTsaurusInt64Attribute(testAccountYTCypressPath, "resource_limits/master_memory/chunk_host", testChunkHostMasterMemory),
+					accCheckYTsaurusInt64Attribute(testAccountYTCypressPath, "resource_limits/master_memory/per_cell/"+testCellTag, testCellMasterMemory),
+					accCheckYTsaurusInt64Attribute(testAccountYTCypressPath, "resource_limits/chunk_host_cell_master_memory/"+testCellTag, testCellMasterMemory),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusAccountConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute(testAccountYTCypressPath, "resource_limits/master_memory/total", testMasterMemory*2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccountResourceCreateParentChild(t *testing.T) {
 
 	resourceChildID := "testaccount"
@@ -328,6 +399,46 @@ func accResourceYtsaurusAccountConfig(id string, m account.AccountModel) string
 			}`
 		}
 
+		if m.ResourceLimits.MasterMemory != nil {
+			config += fmt.Sprintf(`
+			master_memory = {
+				total = %d`, m.ResourceLimits.MasterMemory.Total.ValueInt64())
+
+			if !m.ResourceLimits.MasterMemory.ChunkHost.IsNull() {
+				config += fmt.Sprintf(`
+				chunk_host = %d`, m.ResourceLimits.MasterMemory.ChunkHost.ValueInt64())
+			}
+
+			if len(m.ResourceLimits.MasterMemory.PerCell) > 0 {
+				config += `
+				per_cell = {`
+
+				for k, v := range m.ResourceLimits.MasterMemory.PerCell {
+					config += fmt.Sprintf(`
+					%q = %d`, k, v.ValueInt64())
+				}
+
+				config += `
+				}`
+			}
+
+			config += `
+			}`
+		}
+
+		if len(m.ResourceLimits.ChunkHostCellMasterMemory) > 0 {
+			config += `
+			chunk_host_cell_master_memory = {`
+
+			for k, v := range m.ResourceLimits.ChunkHostCellMasterMemory {
+				config += fmt.Sprintf(`
+				%q = %d`, k, v.ValueInt64())
+			}
+
+			config += `
+			}`
+		}
+
 		config += `
 		}`
 	}
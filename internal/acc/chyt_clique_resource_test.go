@@ -0,0 +1,142 @@
+package acc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/chytclique"
+)
+
+func TestChytCliqueResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "test_chyt_clique"
+	testCliqueAlias := "test_chyt_clique"
+	testCliquePath := fmt.Sprintf("//sys/strawberry/chyt/%s", testCliqueAlias)
+	testCliqueACOPath := fmt.Sprintf("//sys/access_control_object_namespaces/chyt/%s", testCliqueAlias)
+
+	testACL := []yt.ACE{
+		{
+			Action:      yt.ActionAllow,
+			Subjects:    []string{"users"},
+			Permissions: []string{yt.PermissionUse},
+		},
+	}
+
+	configCreate := chytclique.ChytCliqueModel{
+		Alias:         types.StringValue(testCliqueAlias),
+		Pool:          types.StringValue("research"),
+		InstanceCount: types.Int64Value(1),
+	}
+
+	configUpdate := chytclique.ChytCliqueModel{
+		Alias:          types.StringValue(testCliqueAlias),
+		Pool:           types.StringValue("research"),
+		InstanceCount:  types.Int64Value(2),
+		Active:         types.BoolValue(false),
+		SpecletOptions: types.StringValue(`{"instance_cpu": 2}`),
+		ACL:            acl.ToACLModel(testACL),
+	}
+
+	configUnmanagedACL := configUpdate
+	configUnmanagedACL.ACL = nil
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		PreCheck: func() {
+			if _, err := testYTClient.CreateObject(ctx, yt.NodeAccessControlObjectNamespace, &yt.CreateObjectOptions{
+				IgnoreExisting: true,
+				Attributes: map[string]interface{}{
+					"name": "chyt",
+				},
+			}); err != nil {
+				t.Fatal(err)
+			}
+		},
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			accCheckYTsaurusObjectDestroyed(testCliquePath),
+			accCheckYTsaurusObjectDestroyed(testCliqueACOPath),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusChytCliqueConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testCliqueACOPath, "namespace", "chyt"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_chyt_clique.%s", resourceID), "active", "true"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_chyt_clique.%s", resourceID), "stage", "production"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusChytCliqueConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_chyt_clique.%s", resourceID), "acl.#", "1"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_chyt_clique.%s", resourceID), "instance_count", "2"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_chyt_clique.%s", resourceID), "active", "false"),
+				),
+			},
+			{
+				// Dropping acl from the config leaves the ACO principal_acl as is.
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusChytCliqueConfig(resourceID, configUnmanagedACL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusPrincipalACLAttribute(testCliqueACOPath, testACL),
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_chyt_clique.%s", resourceID), "acl.#", "0"),
+				),
+			},
+			{
+				Config:   accGetYTLocalDockerProviderConfig() + accResourceYtsaurusChytCliqueConfig(resourceID, configUnmanagedACL),
+				PlanOnly: true,
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusChytCliqueConfig(resourceID, configUpdate),
+			},
+			{
+				ResourceName:      fmt.Sprintf("ytsaurus_chyt_clique.%s", resourceID),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"speclet_options",
+				},
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusChytCliqueConfig(id string, m chytclique.ChytCliqueModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_chyt_clique" %q {
+		alias = %q`, id, m.Alias.ValueString())
+
+	if !m.Pool.IsNull() {
+		config += fmt.Sprintf(`
+		pool = %q`, m.Pool.ValueString())
+	}
+
+	if !m.InstanceCount.IsNull() {
+		config += fmt.Sprintf(`
+		instance_count = %d`, m.InstanceCount.ValueInt64())
+	}
+
+	if !m.Active.IsNull() {
+		config += fmt.Sprintf(`
+		active = %t`, m.Active.ValueBool())
+	}
+
+	if !m.SpecletOptions.IsNull() {
+		config += fmt.Sprintf(`
+		speclet_options = %q`, m.SpecletOptions.ValueString())
+	}
+
+	principalACL, _ := acl.ToYTsaurusACL(m.ACL)
+	if len(principalACL) > 0 {
+		config += accAddACLConfig(principalACL)
+	}
+
+	config += `
+	}`
+
+	return config
+}
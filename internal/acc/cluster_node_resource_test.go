@@ -0,0 +1,124 @@
+package acc
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+
+	"terraform-provider-ytsaurus/internal/resource/clusternode"
+)
+
+func TestClusterNodeResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "test_cluster_node"
+
+	var addresses []string
+	if err := testYTClient.ListNode(ctx, ypath.Path("//sys/cluster_nodes"), &addresses, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(addresses) == 0 {
+		t.Fatal("no cluster nodes are registered")
+	}
+	testNodeAddress := addresses[0]
+	testNodePath := fmt.Sprintf("//sys/cluster_nodes/%s", testNodeAddress)
+
+	configCreate := clusternode.ClusterNodeModel{
+		Address:  types.StringValue(testNodeAddress),
+		UserTags: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("terraform")}),
+	}
+
+	configUpdate := clusternode.ClusterNodeModel{
+		Address: types.StringValue(testNodeAddress),
+		UserTags: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("terraform"),
+			types.StringValue("terraform_updated"),
+		}),
+		DisableWriteSessions: types.BoolValue(true),
+	}
+
+	// Values set outside of Terraform before the adoption are restored on destroy.
+	testUserTagsPrevious := []string{"preexisting"}
+	userTagsPath := ypath.Path(testNodePath).Attr("user_tags")
+	if err := testYTClient.SetNode(ctx, userTagsPath, testUserTagsPrevious, nil); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = testYTClient.SetNode(ctx, userTagsPath, []string{}, nil)
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			var userTags []string
+			if err := testYTClient.GetNode(ctx, userTagsPath, &userTags, nil); err != nil {
+				return err
+			}
+			if !reflect.DeepEqual(userTags, testUserTagsPrevious) {
+				return fmt.Errorf("node %q expected user tags %v, got %v", testNodeAddress, testUserTagsPrevious, userTags)
+			}
+			return accCheckYTsaurusBoolAttribute(testNodePath, "disable_write_sessions", false)(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusClusterNodeConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_cluster_node.%s", resourceID), "user_tags.#", "1"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_cluster_node.%s", resourceID), "previous_attributes.user_tags.#", "1"),
+					resource.TestCheckNoResourceAttr(fmt.Sprintf("ytsaurus_cluster_node.%s", resourceID), "disable_write_sessions"),
+					accCheckYTsaurusBoolAttribute(testNodePath, "disable_write_sessions", false),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusClusterNodeConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_cluster_node.%s", resourceID), "user_tags.#", "2"),
+					accCheckYTsaurusBoolAttribute(testNodePath, "disable_write_sessions", true),
+				),
+			},
+			{
+				ResourceName:      fmt.Sprintf("ytsaurus_cluster_node.%s", resourceID),
+				ImportState:       true,
+				ImportStateId:     testNodeAddress,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"previous_attributes",
+				},
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusClusterNodeConfig(id string, m clusternode.ClusterNodeModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_cluster_node" %q {
+		address = %q`, id, m.Address.ValueString())
+
+	if !m.UserTags.IsNull() {
+		var userTags []string
+		m.UserTags.ElementsAs(ctx, &userTags, false)
+		config += `
+		user_tags = [`
+		for _, t := range userTags {
+			config += fmt.Sprintf(`
+			%q,`, t)
+		}
+		config += `
+		]`
+	}
+
+	if !m.DisableWriteSessions.IsNull() {
+		config += fmt.Sprintf(`
+		disable_write_sessions = %t`, m.DisableWriteSessions.ValueBool())
+	}
+
+	config += `
+	}`
+
+	return config
+}
@@ -0,0 +1,133 @@
+package acc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/cypressattribute"
+)
+
+func TestCypressAttributeResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "test_cypress_attribute"
+	testNodePath := "//tmp/test_cypress_attribute"
+	testAttributeName := "test_config"
+	testAttributePath := fmt.Sprintf("%s/@%s", testNodePath, testAttributeName)
+	testValue := `{"threads": 4, "enabled": true}`
+	testValueReordered := `{"enabled": true, "threads": 4}`
+	testValueUpdated := `{threads=8; enabled=%false}`
+
+	configInvalidValue := cypressattribute.CypressAttributeModel{
+		Path:  types.StringValue(testNodePath),
+		Name:  types.StringValue(testAttributeName),
+		Value: types.StringValue(`{"threads": `),
+	}
+
+	configCreate := cypressattribute.CypressAttributeModel{
+		Path:  types.StringValue(testNodePath),
+		Name:  types.StringValue(testAttributeName),
+		Value: types.StringValue(testValue),
+	}
+
+	configReordered := cypressattribute.CypressAttributeModel{
+		Path:  types.StringValue(testNodePath),
+		Name:  types.StringValue(testAttributeName),
+		Value: types.StringValue(testValueReordered),
+	}
+
+	configUpdate := cypressattribute.CypressAttributeModel{
+		Path:  types.StringValue(testNodePath),
+		Name:  types.StringValue(testAttributeName),
+		Value: types.StringValue(testValueUpdated),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		PreCheck: func() {
+			if _, err := testYTClient.CreateNode(ctx, ypath.Path(testNodePath), yt.NodeMap, nil); err != nil {
+				t.Fatal(err)
+			}
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			defer func() {
+				_ = testYTClient.RemoveNode(ctx, ypath.Path(testNodePath), nil)
+			}()
+			return accCheckYTsaurusObjectDestroyed(testAttributePath)(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusCypressAttributeConfig(resourceID, configInvalidValue),
+				ExpectError: regexp.MustCompile(`Invalid attribute value`),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusCypressAttributeConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusNodeValue(testAttributePath, testValue),
+				),
+			},
+			{
+				Config:   accGetYTLocalDockerProviderConfig() + accResourceYtsaurusCypressAttributeConfig(resourceID, configReordered),
+				PlanOnly: true,
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusCypressAttributeConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusNodeValue(testAttributePath, `{"threads": 8, "enabled": false}`),
+				),
+			},
+			{
+				ResourceName:            fmt.Sprintf("ytsaurus_cypress_attribute.%s", resourceID),
+				ImportState:             true,
+				ImportStateId:           testAttributePath,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
+
+func TestCypressAttributeResourceObjectID(t *testing.T) {
+	resourceID := "test_cypress_attribute_object_id"
+	testNodePath := "//tmp/test_cypress_attribute_object_id"
+	testAttributeName := "expiration_timeout"
+
+	config := fmt.Sprintf(`
+	resource "ytsaurus_map_node" %q {
+		path = %q
+	}
+
+	resource "ytsaurus_cypress_attribute" %q {
+		path  = "#${ytsaurus_map_node.%s.id}"
+		name  = %q
+		value = "3600000"
+	}`, resourceID, testNodePath, resourceID, resourceID, testAttributeName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testNodePath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute(testNodePath, testAttributeName, 3600000),
+				),
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusCypressAttributeConfig(id string, m cypressattribute.CypressAttributeModel) string {
+	return fmt.Sprintf(`
+	resource "ytsaurus_cypress_attribute" %q {
+		path  = %q
+		name  = %q
+		value = %q
+	}`, id, m.Path.ValueString(), m.Name.ValueString(), m.Value.ValueString())
+}
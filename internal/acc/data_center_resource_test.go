@@ -0,0 +1,59 @@
+package acc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-ytsaurus/internal/resource/datacenter"
+)
+
+func TestDataCenterResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "test_data_center"
+	testDataCenterName := "test_data_center"
+	testDataCenterNameUpdated := "test_data_center_updated"
+
+	configCreate := datacenter.DataCenterModel{
+		Name: types.StringValue(testDataCenterName),
+	}
+
+	configUpdate := datacenter.DataCenterModel{
+		Name: types.StringValue(testDataCenterNameUpdated),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			accCheckYTsaurusObjectDestroyed(fmt.Sprintf("//sys/data_centers/%s", testDataCenterName)),
+			accCheckYTsaurusObjectDestroyed(fmt.Sprintf("//sys/data_centers/%s", testDataCenterNameUpdated)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDataCenterConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(fmt.Sprintf("//sys/data_centers/%s", testDataCenterName), "type", "data_center"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDataCenterConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(fmt.Sprintf("//sys/data_centers/%s", testDataCenterNameUpdated), "name", testDataCenterNameUpdated),
+				),
+			},
+			{
+				ResourceName:      fmt.Sprintf("ytsaurus_data_center.%s", resourceID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusDataCenterConfig(id string, m datacenter.DataCenterModel) string {
+	return fmt.Sprintf(`
+	resource "ytsaurus_data_center" %q {
+		name = %q
+	}`, id, m.Name.ValueString())
+}
@@ -0,0 +1,142 @@
+package acc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/document"
+)
+
+func TestDocumentResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "fakedocument"
+	testDocumentPath := "//tmp/fakedocument"
+	testDocumentTmpAccount := "tmp"
+	testValue := `{"service": {"threads": 4, "enabled": true}, "hosts": ["a", "b"]}`
+	testValueReordered := `{"hosts": ["a", "b"], "service": {"enabled": true, "threads": 4}}`
+	testValueYSON := `{hosts=[a; b]; service={threads=4; enabled=%true}}`
+	testValueUpdated := `{"service": {"threads": 8, "enabled": true}, "hosts": ["a", "b", "c"]}`
+
+	testACL := []yt.ACE{
+		{
+			Action:      yt.ActionAllow,
+			Subjects:    []string{"users"},
+			Permissions: []string{yt.PermissionRead},
+		},
+	}
+
+	configInvalidValue := document.DocumentModel{
+		Path:  types.StringValue(testDocumentPath),
+		Value: types.StringValue(`{"service": `),
+	}
+
+	configCreate := document.DocumentModel{
+		Path:  types.StringValue(testDocumentPath),
+		Value: types.StringValue(testValue),
+	}
+
+	configReordered := document.DocumentModel{
+		Path:  types.StringValue(testDocumentPath),
+		Value: types.StringValue(testValueReordered),
+	}
+
+	configYSON := document.DocumentModel{
+		Path:  types.StringValue(testDocumentPath),
+		Value: types.StringValue(testValueYSON),
+	}
+
+	configUpdate := document.DocumentModel{
+		Path:    types.StringValue(testDocumentPath),
+		Value:   types.StringValue(testValueUpdated),
+		Account: types.StringValue(testDocumentTmpAccount),
+		ACL:     acl.ToACLModel(testACL),
+	}
+
+	var revision uint64
+	revisionPath := ypath.Path(testDocumentPath).Attr("content_revision")
+	saveRevision := func(s *terraform.State) error {
+		return testYTClient.GetNode(ctx, revisionPath, &revision, nil)
+	}
+	checkRevision := func(s *terraform.State) error {
+		var current uint64
+		if err := testYTClient.GetNode(ctx, revisionPath, &current, nil); err != nil {
+			return err
+		}
+		if current != revision {
+			return fmt.Errorf("document %q was rewritten, revision %d -> %d", testDocumentPath, revision, current)
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testDocumentPath),
+		Steps: []resource.TestStep{
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDocumentConfig(resourceID, configInvalidValue),
+				ExpectError: regexp.MustCompile(`Invalid document value`),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDocumentConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testDocumentPath, "type", string(yt.NodeDocument)),
+					accCheckYTsaurusNodeValue(testDocumentPath, testValue),
+					saveRevision,
+				),
+			},
+			{
+				// Semantically equal values don't rewrite the document.
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDocumentConfig(resourceID, configReordered),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusNodeValue(testDocumentPath, testValue),
+					checkRevision,
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_document.%s", resourceID), "value", testValueReordered),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDocumentConfig(resourceID, configYSON),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusNodeValue(testDocumentPath, testValue),
+					checkRevision,
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDocumentConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusNodeValue(testDocumentPath, testValueUpdated),
+					accCheckYTsaurusStringAttribute(testDocumentPath, "account", testDocumentTmpAccount),
+					accCheckYTsaurusACLAttribute(testDocumentPath, testACL),
+				),
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusDocumentConfig(id string, m document.DocumentModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_document" %q {
+		path  = %q
+		value = %q`, id, m.Path.ValueString(), m.Value.ValueString())
+
+	if !m.Account.IsNull() {
+		config += fmt.Sprintf(`
+		account = %q`, m.Account.ValueString())
+	}
+
+	acl, _ := acl.ToYTsaurusACL(m.ACL)
+	if len(acl) > 0 {
+		config += accAddACLConfig(acl)
+	}
+
+	config += `
+	}`
+
+	return config
+}
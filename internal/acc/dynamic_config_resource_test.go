@@ -0,0 +1,157 @@
+package acc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+
+	"terraform-provider-ytsaurus/internal/resource/dynamicconfig"
+)
+
+func TestDynamicConfigResourceCreateAndUpdate(t *testing.T) {
+	testConfigPath := "//sys/http_proxies/@config"
+	testForeignPatch := map[string]interface{}{
+		"terraform_test_foreign": map[string]interface{}{
+			"enabled": true,
+		},
+	}
+
+	configInvalidPatch := dynamicconfig.DynamicConfigModel{
+		Target: types.StringValue("http_proxy"),
+		Patch:  types.StringValue(`[1, 2]`),
+	}
+
+	configEmptyMapPatch := dynamicconfig.DynamicConfigModel{
+		Target: types.StringValue("http_proxy"),
+		Patch:  types.StringValue(`{"terraform_test": {"a": {}}}`),
+	}
+
+	configCreate := dynamicconfig.DynamicConfigModel{
+		Target: types.StringValue("http_proxy"),
+		Patch:  types.StringValue(`{"terraform_test": {"a": 1, "b": {"c": "x"}}}`),
+	}
+
+	configUpdate := dynamicconfig.DynamicConfigModel{
+		Target: types.StringValue("http_proxy"),
+		Patch:  types.StringValue(`{terraform_test={a=2}}`),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		PreCheck: func() {
+			p := ypath.Path(testConfigPath).Child("terraform_test_foreign")
+			if err := testYTClient.SetNode(ctx, p, testForeignPatch["terraform_test_foreign"], nil); err != nil {
+				t.Fatal(err)
+			}
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			defer func() {
+				_ = testYTClient.RemoveNode(ctx, ypath.Path(testConfigPath).Child("terraform_test_foreign"), nil)
+			}()
+			if err := accCheckYTsaurusObjectDestroyed(testConfigPath + "/terraform_test")(s); err != nil {
+				return err
+			}
+			return accCheckYTsaurusBoolAttribute("//sys/http_proxies", "config/terraform_test_foreign/enabled", true)(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicConfigConfig("test", configInvalidPatch),
+				ExpectError: regexp.MustCompile(`patch must be a non-empty map`),
+			},
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicConfigConfig("test", configEmptyMapPatch),
+				ExpectError: regexp.MustCompile(`patch must not contain empty maps`),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicConfigConfig("test", configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute("//sys/http_proxies", "config/terraform_test/a", 1),
+					accCheckYTsaurusStringAttribute("//sys/http_proxies", "config/terraform_test/b/c", "x"),
+					accCheckYTsaurusBoolAttribute("//sys/http_proxies", "config/terraform_test_foreign/enabled", true),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicConfigConfig("test", configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute("//sys/http_proxies", "config/terraform_test/a", 2),
+					accCheckYTsaurusObjectDestroyed(testConfigPath+"/terraform_test/b/c"),
+					accCheckYTsaurusBoolAttribute("//sys/http_proxies", "config/terraform_test_foreign/enabled", true),
+				),
+			},
+		},
+	})
+}
+
+func TestDynamicConfigResourceSharedTarget(t *testing.T) {
+	testConfigPath := "//sys/http_proxies/@config/terraform_test_shared"
+
+	configFirst := dynamicconfig.DynamicConfigModel{
+		Target: types.StringValue("http_proxy"),
+		Patch:  types.StringValue(`{"terraform_test_shared": {"first": {"a": 1}}}`),
+	}
+
+	configSecond := dynamicconfig.DynamicConfigModel{
+		Target: types.StringValue("http_proxy"),
+		Patch:  types.StringValue(`{"terraform_test_shared": {"second": {"b": 2}}}`),
+	}
+
+	configSecondUpdate := dynamicconfig.DynamicConfigModel{
+		Target: types.StringValue("http_proxy"),
+		Patch:  types.StringValue(`{"terraform_test_shared": {"second": {"b": 3}}}`),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			defer func() {
+				_ = testYTClient.RemoveNode(ctx, ypath.Path(testConfigPath), nil)
+			}()
+			if err := accCheckYTsaurusObjectDestroyed(testConfigPath + "/first/a")(s); err != nil {
+				return err
+			}
+			return accCheckYTsaurusObjectDestroyed(testConfigPath + "/second/b")(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() +
+					accResourceYtsaurusDynamicConfigConfig("first", configFirst) +
+					accResourceYtsaurusDynamicConfigConfig("second", configSecond),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute("//sys/http_proxies", "config/terraform_test_shared/first/a", 1),
+					accCheckYTsaurusInt64Attribute("//sys/http_proxies", "config/terraform_test_shared/second/b", 2),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() +
+					accResourceYtsaurusDynamicConfigConfig("first", configFirst) +
+					accResourceYtsaurusDynamicConfigConfig("second", configSecondUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute("//sys/http_proxies", "config/terraform_test_shared/first/a", 1),
+					accCheckYTsaurusInt64Attribute("//sys/http_proxies", "config/terraform_test_shared/second/b", 3),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() +
+					accResourceYtsaurusDynamicConfigConfig("second", configSecondUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusObjectDestroyed(testConfigPath+"/first/a"),
+					accCheckYTsaurusInt64Attribute("//sys/http_proxies", "config/terraform_test_shared/second/b", 3),
+				),
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusDynamicConfigConfig(id string, m dynamicconfig.DynamicConfigModel) string {
+	return fmt.Sprintf(`
+	resource "ytsaurus_dynamic_config" %q {
+		target = %q
+		patch  = %q
+	}`, id, m.Target.ValueString(), m.Patch.ValueString())
+}
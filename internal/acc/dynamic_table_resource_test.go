@@ -0,0 +1,253 @@
+package acc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/dynamictable"
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+)
+
+func TestDynamicTableResourceMisconfigurations(t *testing.T) {
+	resourceID := "fakedyntable"
+	testTablePath := "//tmp/fakedyntable"
+
+	orderedSchema := &tableschema.TableSchemaModel{
+		Columns: []tableschema.ColumnModel{
+			{
+				Name: types.StringValue("value"),
+				Type: types.StringValue("string"),
+			},
+		},
+	}
+
+	configWithoutSchema := dynamictable.DynamicTableModel{
+		Path: types.StringValue(testTablePath),
+	}
+
+	configOrderedWithPivotKeys := dynamictable.DynamicTableModel{
+		Path:      types.StringValue(testTablePath),
+		Schema:    orderedSchema,
+		PivotKeys: types.StringValue(`[[], ["b"]]`),
+	}
+
+	configSortedWithoutUniqueKeys := dynamictable.DynamicTableModel{
+		Path: types.StringValue(testTablePath),
+		Schema: &tableschema.TableSchemaModel{
+			UniqueKeys: types.BoolValue(false),
+			Columns: []tableschema.ColumnModel{
+				{
+					Name:      types.StringValue("key"),
+					Type:      types.StringValue("string"),
+					SortOrder: types.StringValue("ascending"),
+				},
+			},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicTableConfig(resourceID, configWithoutSchema),
+				ExpectError: regexp.MustCompile(`The argument "schema" is required, but no definition was found.`),
+			},
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicTableConfig(resourceID, configOrderedWithPivotKeys),
+				ExpectError: regexp.MustCompile(`pivot_keys can be set only for sorted tables`),
+			},
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicTableConfig(resourceID, configSortedWithoutUniqueKeys),
+				ExpectError: regexp.MustCompile(`Sorted dynamic tables require unique_keys to be true`),
+			},
+		},
+	})
+}
+
+func TestDynamicTableResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "fakedyntable"
+	testTablePath := "//tmp/fakedyntable"
+	testPivotKeys := `[[], ["m"]]`
+	testTabletCount := int64(3)
+
+	sortedSchema := &tableschema.TableSchemaModel{
+		UniqueKeys: types.BoolValue(true),
+		Columns: []tableschema.ColumnModel{
+			{
+				Name:      types.StringValue("key"),
+				Type:      types.StringValue("string"),
+				SortOrder: types.StringValue("ascending"),
+			},
+			{
+				Name: types.StringValue("value"),
+				Type: types.StringValue("string"),
+			},
+		},
+	}
+
+	configCreate := dynamictable.DynamicTableModel{
+		Path:      types.StringValue(testTablePath),
+		Schema:    sortedSchema,
+		PivotKeys: types.StringValue(testPivotKeys),
+	}
+
+	configFreeze := dynamictable.DynamicTableModel{
+		Path:         types.StringValue(testTablePath),
+		Schema:       sortedSchema,
+		PivotKeys:    types.StringValue(testPivotKeys),
+		InMemoryMode: types.StringValue("compressed"),
+		State:        types.StringValue(yt.TabletFrozen),
+	}
+
+	configReshard := dynamictable.DynamicTableModel{
+		Path:        types.StringValue(testTablePath),
+		Schema:      sortedSchema,
+		TabletCount: types.Int64Value(testTabletCount),
+		State:       types.StringValue(yt.TabletUnmounted),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testTablePath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicTableConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusBoolAttribute(testTablePath, "dynamic", true),
+					accCheckYTsaurusStringAttribute(testTablePath, "tablet_state", yt.TabletMounted),
+					accCheckYTsaurusInt64Attribute(testTablePath, "tablet_count", 2),
+					accCheckYTsaurusStringAttribute(testTablePath, "tablet_cell_bundle", "default"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicTableConfig(resourceID, configFreeze),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testTablePath, "tablet_state", yt.TabletFrozen),
+					accCheckYTsaurusStringAttribute(testTablePath, "in_memory_mode", "compressed"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicTableConfig(resourceID, configReshard),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testTablePath, "tablet_state", yt.TabletUnmounted),
+					accCheckYTsaurusInt64Attribute(testTablePath, "tablet_count", testTabletCount),
+				),
+			},
+		},
+	})
+}
+
+func TestDynamicTableResourceSortedDefaults(t *testing.T) {
+	resourceID := "fakedyntable"
+	testTablePath := "//tmp/fakedyntable"
+
+	// unique_keys is not set, it defaults to true for sorted tables.
+	sortedSchema := &tableschema.TableSchemaModel{
+		Columns: []tableschema.ColumnModel{
+			{
+				Name:      types.StringValue("key"),
+				Type:      types.StringValue("string"),
+				SortOrder: types.StringValue("ascending"),
+			},
+			{
+				Name: types.StringValue("value"),
+				Type: types.StringValue("string"),
+			},
+		},
+	}
+
+	configCreate := dynamictable.DynamicTableModel{
+		Path:   types.StringValue(testTablePath),
+		Schema: sortedSchema,
+	}
+
+	configUpdate := dynamictable.DynamicTableModel{
+		Path:         types.StringValue(testTablePath),
+		Schema:       sortedSchema,
+		InMemoryMode: types.StringValue("compressed"),
+	}
+
+	configReshard := dynamictable.DynamicTableModel{
+		Path:         types.StringValue(testTablePath),
+		Schema:       sortedSchema,
+		InMemoryMode: types.StringValue("compressed"),
+		TabletCount:  types.Int64Value(2),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testTablePath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicTableConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testTablePath, "tablet_state", yt.TabletMounted),
+					resource.TestCheckResourceAttr("ytsaurus_dynamic_table."+resourceID, "schema.unique_keys", "true"),
+				),
+			},
+			{
+				// pivot_keys and tablet_count are kept from the state on unrelated updates.
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicTableConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testTablePath, "in_memory_mode", "compressed"),
+					resource.TestCheckResourceAttr("ytsaurus_dynamic_table."+resourceID, "tablet_count", "1"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusDynamicTableConfig(resourceID, configReshard),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute(testTablePath, "tablet_count", 2),
+				),
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusDynamicTableConfig(id string, m dynamictable.DynamicTableModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_dynamic_table" %q {`, id)
+
+	if !m.Path.IsNull() {
+		config += fmt.Sprintf(`
+		path = %q`, m.Path.ValueString())
+	}
+
+	if m.Schema != nil {
+		config += accAddTableSchemaConfig(m.Schema)
+	}
+
+	if !m.TabletCellBundle.IsNull() {
+		config += fmt.Sprintf(`
+		tablet_cell_bundle = %q`, m.TabletCellBundle.ValueString())
+	}
+
+	if !m.PivotKeys.IsNull() {
+		config += fmt.Sprintf(`
+		pivot_keys = %q`, m.PivotKeys.ValueString())
+	}
+
+	if !m.TabletCount.IsNull() {
+		config += fmt.Sprintf(`
+		tablet_count = %d`, m.TabletCount.ValueInt64())
+	}
+
+	if !m.InMemoryMode.IsNull() {
+		config += fmt.Sprintf(`
+		in_memory_mode = %q`, m.InMemoryMode.ValueString())
+	}
+
+	if !m.State.IsNull() {
+		config += fmt.Sprintf(`
+		state = %q`, m.State.ValueString())
+	}
+
+	config += `
+	}`
+
+	return config
+}
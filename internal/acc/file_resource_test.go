@@ -0,0 +1,104 @@
+package acc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/file"
+)
+
+func TestFileResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "fakefile"
+	testFilePath := "//tmp/fakefile"
+	testContent := "threads: 4\n"
+	testContentMD5 := "f0aab93f68ac8e50c45a8bf2442d963a"
+	testSourceContent := "#!/bin/sh\necho hello\n"
+
+	testSource := filepath.Join(t.TempDir(), "fakefile.sh")
+	if err := os.WriteFile(testSource, []byte(testSourceContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	configConflict := file.FileModel{
+		Path:    types.StringValue(testFilePath),
+		Source:  types.StringValue(testSource),
+		Content: types.StringValue(testContent),
+	}
+
+	configContent := file.FileModel{
+		Path:    types.StringValue(testFilePath),
+		Content: types.StringValue(testContent),
+	}
+
+	configSource := file.FileModel{
+		Path:              types.StringValue(testFilePath),
+		Source:            types.StringValue(testSource),
+		Executable:        types.BoolValue(true),
+		ReplicationFactor: types.Int64Value(1),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testFilePath),
+		Steps: []resource.TestStep{
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusFileConfig(resourceID, configConflict),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusFileConfig(resourceID, configContent),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testFilePath, "type", string(yt.NodeFile)),
+					accCheckYTsaurusStringAttribute(testFilePath, "md5", testContentMD5),
+					resource.TestCheckResourceAttr("ytsaurus_file."+resourceID, "md5", testContentMD5),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusFileConfig(resourceID, configSource),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusBoolAttribute(testFilePath, "executable", true),
+					accCheckYTsaurusInt64Attribute(testFilePath, "replication_factor", 1),
+					accCheckYTsaurusInt64Attribute(testFilePath, "uncompressed_data_size", int64(len(testSourceContent))),
+				),
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusFileConfig(id string, m file.FileModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_file" %q {
+		path = %q`, id, m.Path.ValueString())
+
+	if !m.Source.IsNull() {
+		config += fmt.Sprintf(`
+		source = %q`, m.Source.ValueString())
+	}
+
+	if !m.Content.IsNull() {
+		config += fmt.Sprintf(`
+		content = %q`, m.Content.ValueString())
+	}
+
+	if !m.Executable.IsNull() {
+		config += fmt.Sprintf(`
+		executable = %t`, m.Executable.ValueBool())
+	}
+
+	if !m.ReplicationFactor.IsNull() {
+		config += fmt.Sprintf(`
+		replication_factor = %d`, m.ReplicationFactor.ValueInt64())
+	}
+
+	config += `
+	}`
+
+	return config
+}
@@ -0,0 +1,80 @@
+package acc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/groupmembership"
+)
+
+func TestGroupMembershipResource(t *testing.T) {
+	resourceID := "test_group_membership"
+	testGroupName := "test_group_membership_group"
+	testUserName := "test_group_membership_user"
+	testOtherUserName := "test_group_membership_other_user"
+	testGroupYTCypressPath := fmt.Sprintf("//sys/groups/%s", testGroupName)
+
+	config := groupmembership.GroupMembershipModel{
+		Group:  types.StringValue(testGroupName),
+		Member: types.StringValue(testUserName),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		PreCheck: func() {
+			for _, u := range []string{testUserName, testOtherUserName} {
+				if _, err := testYTClient.CreateObject(ctx, yt.NodeUser, &yt.CreateObjectOptions{
+					Attributes: map[string]interface{}{"name": u},
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err := testYTClient.CreateObject(ctx, yt.NodeGroup, &yt.CreateObjectOptions{
+				Attributes: map[string]interface{}{"name": testGroupName},
+			}); err != nil {
+				t.Fatal(err)
+			}
+			// A membership not owned by Terraform must survive the whole test.
+			if err := testYTClient.AddMember(ctx, testGroupName, testOtherUserName, nil); err != nil {
+				t.Fatal(err)
+			}
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			defer func() {
+				_ = testYTClient.RemoveNode(ctx, ypath.Path(testGroupYTCypressPath), nil)
+				_ = testYTClient.RemoveNode(ctx, ypath.Path(fmt.Sprintf("//sys/users/%s", testUserName)), nil)
+				_ = testYTClient.RemoveNode(ctx, ypath.Path(fmt.Sprintf("//sys/users/%s", testOtherUserName)), nil)
+			}()
+			return accCheckYTsaurusGroupMembers(testGroupYTCypressPath, []string{testOtherUserName})(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusGroupMembershipConfig(resourceID, config),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusGroupMembers(testGroupYTCypressPath, []string{testUserName, testOtherUserName}),
+				),
+			},
+			{
+				ResourceName:      fmt.Sprintf("ytsaurus_group_membership.%s", resourceID),
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", testGroupName, testUserName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusGroupMembershipConfig(id string, m groupmembership.GroupMembershipModel) string {
+	return fmt.Sprintf(`
+	resource "ytsaurus_group_membership" %q {
+		group  = %q
+		member = %q
+	}`, id, m.Group.ValueString(), m.Member.ValueString())
+}
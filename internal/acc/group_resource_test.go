@@ -3,8 +3,10 @@ package acc
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
@@ -25,10 +27,32 @@ func TestGroupResource(t *testing.T) {
 		Name: types.StringValue(testGroupName),
 	}
 
+	configUnknownMember := group.GroupModel{
+		Name: types.StringValue(testGroupName),
+		Members: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("testgroup_unknown_member"),
+		}),
+	}
+
 	configUpdate := group.GroupModel{
 		Name: types.StringValue(testGroupNameUpdated),
 	}
 
+	configAddMembers := group.GroupModel{
+		Name: types.StringValue(testGroupNameUpdated),
+		Members: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("root"),
+			types.StringValue("admins"),
+		}),
+	}
+
+	configRemoveMember := group.GroupModel{
+		Name: types.StringValue(testGroupNameUpdated),
+		Members: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("admins"),
+		}),
+	}
+
 	// create test group -> rename test group -> delete test group
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
@@ -38,6 +62,11 @@ func TestGroupResource(t *testing.T) {
 				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusGroupConfig(resourceID, configEmpty),
 				ExpectError: regexp.MustCompile(`The argument "name" is required, but no definition was found.`),
 			},
+			{
+				// The group is removed when a member can't be added, so the next step can create it again.
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusGroupConfig(resourceID, configUnknownMember),
+				ExpectError: regexp.MustCompile(`Error adding member to group`),
+			},
 			{
 				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusGroupConfig(resourceID, configCreate),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -50,6 +79,41 @@ func TestGroupResource(t *testing.T) {
 					accCheckYTsaurusStringAttribute(testGroupYTCypressPathUpdated, "name", testGroupNameUpdated),
 				),
 			},
+			{
+				// A member added before members are managed by Terraform is adopted, not added twice.
+				PreConfig: func() {
+					if err := testYTClient.AddMember(ctx, testGroupNameUpdated, "admins", nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusGroupConfig(resourceID, configAddMembers),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusGroupMembers(testGroupYTCypressPathUpdated, []string{"root", "admins"}),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusGroupConfig(resourceID, configRemoveMember),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusGroupMembers(testGroupYTCypressPathUpdated, []string{"admins"}),
+				),
+			},
+			{
+				// A member added outside of Terraform is reported as drift.
+				PreConfig: func() {
+					if err := testYTClient.AddMember(ctx, testGroupNameUpdated, "root", nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             accGetYTLocalDockerProviderConfig() + accResourceYtsaurusGroupConfig(resourceID, configRemoveMember),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusGroupConfig(resourceID, configRemoveMember),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusGroupMembers(testGroupYTCypressPathUpdated, []string{"admins"}),
+				),
+			},
 		},
 	})
 }
@@ -63,6 +127,15 @@ func accResourceYtsaurusGroupConfig(id string, m group.GroupModel) string {
 		name = %q`, m.Name.ValueString())
 	}
 
+	if !m.Members.IsNull() {
+		var members []string
+		for _, v := range m.Members.Elements() {
+			members = append(members, fmt.Sprintf("%q", v.(types.String).ValueString()))
+		}
+		config += fmt.Sprintf(`
+		members = [%s]`, strings.Join(members, ", "))
+	}
+
 	config += `
 	}`
 
@@ -0,0 +1,95 @@
+package acc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/link"
+)
+
+func TestLinkResourceCreateAndRetarget(t *testing.T) {
+	resourceID := "fakelink"
+	testLinkPath := "//tmp/fakelink_current"
+	testTargetV1 := "//tmp/fakelink_v1"
+	testTargetV2 := "//tmp/fakelink_v2"
+
+	configCreate := link.LinkModel{
+		Path:       types.StringValue(testLinkPath),
+		TargetPath: types.StringValue(testTargetV1),
+	}
+
+	configRetarget := link.LinkModel{
+		Path:       types.StringValue(testLinkPath),
+		TargetPath: types.StringValue(testTargetV2),
+	}
+
+	t.Cleanup(func() {
+		for _, p := range []string{testTargetV1, testTargetV2} {
+			_ = testYTClient.RemoveNode(ctx, ypath.Path(p), &yt.RemoveNodeOptions{Force: true})
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testLinkPath + "&"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					for _, p := range []string{testTargetV1, testTargetV2} {
+						if _, err := testYTClient.CreateNode(ctx, ypath.Path(p), yt.NodeMap, &yt.CreateNodeOptions{IgnoreExisting: true}); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusLinkConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testLinkPath+"&", "type", string(yt.NodeLink)),
+					accCheckYTsaurusStringAttribute(testLinkPath+"&", "target_path", testTargetV1),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusLinkConfig(resourceID, configRetarget),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testLinkPath+"&", "target_path", testTargetV2),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := testYTClient.RemoveNode(ctx, ypath.Path(testTargetV2), nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             accGetYTLocalDockerProviderConfig() + accResourceYtsaurusLinkConfig(resourceID, configRetarget),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusLinkConfig(id string, m link.LinkModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_link" %q {
+		path        = %q
+		target_path = %q`, id, m.Path.ValueString(), m.TargetPath.ValueString())
+
+	if !m.Recursive.IsNull() {
+		config += fmt.Sprintf(`
+		recursive = %t`, m.Recursive.ValueBool())
+	}
+
+	if !m.Force.IsNull() {
+		config += fmt.Sprintf(`
+		force = %t`, m.Force.ValueBool())
+	}
+
+	config += `
+	}`
+
+	return config
+}
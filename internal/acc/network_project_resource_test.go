@@ -0,0 +1,82 @@
+package acc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/networkproject"
+)
+
+func TestNetworkProjectResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "test_network_project"
+	testNetworkProjectName := resourceID
+	testNetworkProjectPath := fmt.Sprintf("//sys/network_projects/%s", testNetworkProjectName)
+
+	testACL := []yt.ACE{
+		{
+			Action:      yt.ActionAllow,
+			Subjects:    []string{"users"},
+			Permissions: []string{yt.PermissionUse},
+		},
+	}
+
+	configCreate := networkproject.NetworkProjectModel{
+		Name:      types.StringValue(testNetworkProjectName),
+		ProjectID: types.Int64Value(1234),
+	}
+
+	configUpdate := networkproject.NetworkProjectModel{
+		Name:      types.StringValue(testNetworkProjectName),
+		ProjectID: types.Int64Value(4321),
+		ACL:       acl.ToACLModel(testACL),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testNetworkProjectPath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusNetworkProjectConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testNetworkProjectPath, "type", "network_project"),
+					accCheckYTsaurusUInt64Attribute(testNetworkProjectPath, "project_id", 1234),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusNetworkProjectConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusUInt64Attribute(testNetworkProjectPath, "project_id", 4321),
+					accCheckYTsaurusACLAttribute(testNetworkProjectPath, testACL),
+				),
+			},
+			{
+				ResourceName:      fmt.Sprintf("ytsaurus_network_project.%s", resourceID),
+				ImportState:       true,
+				ImportStateId:     testNetworkProjectName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusNetworkProjectConfig(id string, m networkproject.NetworkProjectModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_network_project" %q {
+		name       = %q
+		project_id = %d`, id, m.Name.ValueString(), m.ProjectID.ValueInt64())
+
+	acl, _ := acl.ToYTsaurusACL(m.ACL)
+	if len(acl) > 0 {
+		config += accAddACLConfig(acl)
+	}
+
+	config += `
+	}`
+
+	return config
+}
@@ -0,0 +1,105 @@
+package acc
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/portal"
+)
+
+func TestPortalResourceCreateAndUpdate(t *testing.T) {
+	var cellTags []string
+	if err := testYTClient.ListNode(ctx, ypath.Path("//sys/secondary_masters"), &cellTags, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(cellTags) == 0 {
+		t.Skip("portals require a multicell cluster")
+	}
+	exitCellTag, err := strconv.ParseInt(cellTags[0], 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceID := "project"
+	testPortalPath := "//home/fakeportal"
+
+	testACL := []yt.ACE{
+		{
+			Action:          yt.ActionAllow,
+			Subjects:        []string{"users"},
+			Permissions:     []string{yt.PermissionRead},
+			InheritanceMode: "object_and_descendants",
+		},
+	}
+
+	configCreate := portal.PortalModel{
+		Path:        types.StringValue(testPortalPath),
+		ExitCellTag: types.Int64Value(exitCellTag),
+	}
+
+	configUpdate := portal.PortalModel{
+		Path:        types.StringValue(testPortalPath),
+		ExitCellTag: types.Int64Value(exitCellTag),
+		Account:     types.StringValue("sys"),
+		InheritACL:  types.BoolValue(false),
+		ACL:         acl.ToACLModel(testACL),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testPortalPath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusPortalConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testPortalPath+"&", "type", string(yt.NodePortalEntrance)),
+					accCheckYTsaurusInt64Attribute(testPortalPath+"&", "exit_cell_tag", exitCellTag),
+					accCheckYTsaurusStringAttribute(testPortalPath, "account", "default"),
+					resource.TestCheckResourceAttrSet("ytsaurus_portal."+resourceID, "exit_node_id"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusPortalConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testPortalPath, "account", "sys"),
+					accCheckYTsaurusBoolAttribute(testPortalPath, "inherit_acl", false),
+					accCheckYTsaurusACLAttribute(testPortalPath, testACL),
+				),
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusPortalConfig(id string, p portal.PortalModel) string {
+	config := fmt.Sprintf(`
+		resource "ytsaurus_portal" %q {
+			path          = %q
+			exit_cell_tag = %d`, id, p.Path.ValueString(), p.ExitCellTag.ValueInt64())
+
+	if !p.Account.IsNull() {
+		config += fmt.Sprintf(`
+			account = %q`, p.Account.ValueString())
+	}
+
+	if !p.InheritACL.IsNull() {
+		config += fmt.Sprintf(`
+			inherit_acl = %t`, p.InheritACL.ValueBool())
+	}
+
+	acl, _ := acl.ToYTsaurusACL(p.ACL)
+	if len(acl) > 0 {
+		config += accAddACLConfig(acl)
+	}
+
+	config += `
+		}`
+
+	return config
+}
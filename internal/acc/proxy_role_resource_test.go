@@ -0,0 +1,153 @@
+package acc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/proxyrole"
+)
+
+func TestProxyRoleResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "test_proxy_role"
+	testProxyRoleName := resourceID
+	testProxyRolePath := fmt.Sprintf("//sys/http_proxy_roles/%s", testProxyRoleName)
+
+	testACL := []yt.ACE{
+		{
+			Action:      yt.ActionAllow,
+			Subjects:    []string{"users"},
+			Permissions: []string{yt.PermissionUse},
+		},
+	}
+
+	configCreate := proxyrole.ProxyRoleModel{
+		Name:      types.StringValue(testProxyRoleName),
+		ProxyKind: types.StringValue("http"),
+	}
+
+	configUpdate := proxyrole.ProxyRoleModel{
+		Name:      types.StringValue(testProxyRoleName),
+		ProxyKind: types.StringValue("http"),
+		ACL:       acl.ToACLModel(testACL),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testProxyRolePath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusProxyRoleConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testProxyRolePath, "proxy_kind", "http"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusProxyRoleConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusACLAttribute(testProxyRolePath, testACL),
+				),
+			},
+			{
+				ResourceName:      fmt.Sprintf("ytsaurus_proxy_role.%s", resourceID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestProxyRoleAssignmentResource(t *testing.T) {
+	resourceID := "test_proxy_role_assignment"
+	testProxyRoleName := resourceID
+
+	var addresses []string
+	if err := testYTClient.ListNode(ctx, ypath.Path("//sys/rpc_proxies"), &addresses, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(addresses) == 0 {
+		t.Skip("no rpc proxies are registered")
+	}
+	testProxyAddress := addresses[0]
+	testProxyPath := fmt.Sprintf("//sys/rpc_proxies/%s", testProxyAddress)
+
+	var previousRole *string
+	if ok, err := testYTClient.NodeExists(ctx, ypath.Path(testProxyPath).Attr("role"), nil); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		var role string
+		if err := testYTClient.GetNode(ctx, ypath.Path(testProxyPath).Attr("role"), &role, nil); err != nil {
+			t.Fatal(err)
+		}
+		previousRole = &role
+	}
+
+	config := func(role string) string {
+		return fmt.Sprintf(`
+	resource "ytsaurus_proxy_role" %q {
+		name       = %q
+		proxy_kind = "rpc"
+	}
+
+	resource "ytsaurus_proxy_role_assignment" %q {
+		proxy_kind = "rpc"
+		address    = %q
+		role       = %s
+	}`, resourceID, testProxyRoleName, resourceID, testProxyAddress, role)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if previousRole != nil {
+				return accCheckYTsaurusStringAttribute(testProxyPath, "role", *previousRole)(s)
+			}
+			return accCheckYTsaurusObjectDestroyed(fmt.Sprintf("%s/@role", testProxyPath))(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + config(fmt.Sprintf("ytsaurus_proxy_role.%s.name", resourceID)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testProxyPath, "role", testProxyRoleName),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + config(`"default"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testProxyPath, "role", "default"),
+				),
+			},
+			{
+				ResourceName:            fmt.Sprintf("ytsaurus_proxy_role_assignment.%s", resourceID),
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("rpc/%s", testProxyAddress),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"previous_role"},
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusProxyRoleConfig(id string, m proxyrole.ProxyRoleModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_proxy_role" %q {
+		name       = %q
+		proxy_kind = %q`, id, m.Name.ValueString(), m.ProxyKind.ValueString())
+
+	acl, _ := acl.ToYTsaurusACL(m.ACL)
+	if len(acl) > 0 {
+		config += accAddACLConfig(acl)
+	}
+
+	config += `
+	}`
+
+	return config
+}
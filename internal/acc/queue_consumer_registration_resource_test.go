@@ -0,0 +1,131 @@
+package acc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"go.ytsaurus.tech/yt/go/schema"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/queueconsumerregistration"
+)
+
+func TestQueueConsumerRegistrationResource(t *testing.T) {
+	resourceID := "test_queue_consumer_registration"
+	testQueuePath := "//home/test_queue_consumer_registration_queue"
+	testConsumerPath := "//home/test_queue_consumer_registration_consumer"
+
+	configCreate := queueconsumerregistration.QueueConsumerRegistrationModel{
+		QueuePath:    types.StringValue(testQueuePath),
+		ConsumerPath: types.StringValue(testConsumerPath),
+		Vital:        types.BoolValue(false),
+	}
+
+	configUpdate := queueconsumerregistration.QueueConsumerRegistrationModel{
+		QueuePath:    types.StringValue(testQueuePath),
+		ConsumerPath: types.StringValue(testConsumerPath),
+		Vital:        types.BoolValue(true),
+		Partitions:   types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(0)}),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		PreCheck: func() {
+			queueSchema := schema.Schema{
+				Columns: []schema.Column{
+					{Name: "data", Type: schema.TypeString},
+				},
+			}
+			consumerSchema := schema.Schema{
+				UniqueKeys: true,
+				Columns: []schema.Column{
+					{Name: "queue_cluster", Type: schema.TypeString, SortOrder: schema.SortAscending},
+					{Name: "queue_path", Type: schema.TypeString, SortOrder: schema.SortAscending},
+					{Name: "partition_index", Type: schema.TypeUint64, SortOrder: schema.SortAscending},
+					{Name: "offset", Type: schema.TypeUint64},
+				},
+			}
+			tables := map[string]map[string]interface{}{
+				testQueuePath: {
+					"dynamic": true,
+					"schema":  queueSchema,
+				},
+				testConsumerPath: {
+					"dynamic":                 true,
+					"schema":                  consumerSchema,
+					"treat_as_queue_consumer": true,
+				},
+			}
+			for p, attributes := range tables {
+				if _, err := testYTClient.CreateNode(ctx, ypath.Path(p), yt.NodeTable, &yt.CreateNodeOptions{
+					Attributes: attributes,
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			defer func() {
+				_ = testYTClient.RemoveNode(ctx, ypath.Path(testQueuePath), nil)
+				_ = testYTClient.RemoveNode(ctx, ypath.Path(testConsumerPath), nil)
+			}()
+			var registrations []interface{}
+			if err := testYTClient.GetNode(ctx, ypath.Path(testQueuePath).Attr("queue_consumers"), &registrations, nil); err == nil && len(registrations) > 0 {
+				return fmt.Errorf("consumer %q is still registered", testConsumerPath)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusQueueConsumerRegistrationConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_queue_consumer_registration.%s", resourceID), "vital", "false"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusQueueConsumerRegistrationConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("ytsaurus_queue_consumer_registration.%s", resourceID), "vital", "true"),
+				),
+			},
+			{
+				ResourceName:      fmt.Sprintf("ytsaurus_queue_consumer_registration.%s", resourceID),
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s;%s", testQueuePath, testConsumerPath),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusQueueConsumerRegistrationConfig(id string, m queueconsumerregistration.QueueConsumerRegistrationModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_queue_consumer_registration" %q {
+		queue_path    = %q
+		consumer_path = %q
+		vital         = %t`, id, m.QueuePath.ValueString(), m.ConsumerPath.ValueString(), m.Vital.ValueBool())
+
+	if !m.Partitions.IsNull() {
+		var partitions []int64
+		m.Partitions.ElementsAs(ctx, &partitions, false)
+		config += `
+		partitions = [`
+		for _, p := range partitions {
+			config += fmt.Sprintf(`
+			%d,`, p)
+		}
+		config += `
+		]`
+	}
+
+	config += `
+	}`
+
+	return config
+}
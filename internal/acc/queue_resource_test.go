@@ -0,0 +1,170 @@
+package acc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/queue"
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+)
+
+func TestQueueResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "test_queue"
+	testQueuePath := "//tmp/test_queue"
+
+	orderedSchema := &tableschema.TableSchemaModel{
+		Columns: []tableschema.ColumnModel{
+			{
+				Name: types.StringValue("data"),
+				Type: types.StringValue("string"),
+			},
+		},
+	}
+
+	sortedSchema := &tableschema.TableSchemaModel{
+		UniqueKeys: types.BoolValue(true),
+		Columns: []tableschema.ColumnModel{
+			{
+				Name:      types.StringValue("key"),
+				Type:      types.StringValue("string"),
+				SortOrder: types.StringValue("ascending"),
+			},
+		},
+	}
+
+	configSorted := queue.QueueModel{
+		Path:   types.StringValue(testQueuePath),
+		Schema: sortedSchema,
+	}
+
+	configCreate := queue.QueueModel{
+		Path:        types.StringValue(testQueuePath),
+		Schema:      orderedSchema,
+		TabletCount: types.Int64Value(2),
+	}
+
+	configUpdate := queue.QueueModel{
+		Path:        types.StringValue(testQueuePath),
+		Schema:      orderedSchema,
+		TabletCount: types.Int64Value(3),
+		AutoTrimConfig: &queue.QueueAutoTrimConfigModel{
+			Enable:       types.BoolValue(true),
+			RetainedRows: types.Int64Value(1000),
+		},
+		StaticExportConfig: map[string]queue.QueueStaticExportConfigModel{
+			"default": {
+				ExportDirectory: types.StringValue("//tmp"),
+				ExportPeriod:    types.Int64Value(60000),
+			},
+		},
+		QueueAgentStage: types.StringValue("testing"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testQueuePath),
+		Steps: []resource.TestStep{
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusQueueConfig(resourceID, configSorted),
+				ExpectError: regexp.MustCompile(`A queue must be an ordered table`),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusQueueConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusBoolAttribute(testQueuePath, "dynamic", true),
+					accCheckYTsaurusStringAttribute(testQueuePath, "tablet_state", yt.TabletMounted),
+					accCheckYTsaurusInt64Attribute(testQueuePath, "tablet_count", 2),
+					accCheckYTsaurusStringAttribute(testQueuePath, "queue_agent_stage", "production"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusQueueConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testQueuePath, "tablet_state", yt.TabletMounted),
+					accCheckYTsaurusInt64Attribute(testQueuePath, "tablet_count", 3),
+					accCheckYTsaurusBoolAttribute(testQueuePath, "auto_trim_config/enable", true),
+					accCheckYTsaurusInt64Attribute(testQueuePath, "auto_trim_config/retained_rows", 1000),
+					accCheckYTsaurusStringAttribute(testQueuePath, "static_export_config/default/export_directory", "//tmp"),
+					accCheckYTsaurusStringAttribute(testQueuePath, "queue_agent_stage", "testing"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusQueueConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute(testQueuePath, "tablet_count", 2),
+					accCheckYTsaurusStringAttribute(testQueuePath, "queue_agent_stage", "production"),
+				),
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusQueueConfig(id string, m queue.QueueModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_queue" %q {`, id)
+
+	if !m.Path.IsNull() {
+		config += fmt.Sprintf(`
+		path = %q`, m.Path.ValueString())
+	}
+
+	if m.Schema != nil {
+		config += accAddTableSchemaConfig(m.Schema)
+	}
+
+	if !m.TabletCount.IsNull() {
+		config += fmt.Sprintf(`
+		tablet_count = %d`, m.TabletCount.ValueInt64())
+	}
+
+	if !m.State.IsNull() {
+		config += fmt.Sprintf(`
+		state = %q`, m.State.ValueString())
+	}
+
+	if m.AutoTrimConfig != nil {
+		config += fmt.Sprintf(`
+		auto_trim_config = {
+			enable = %t`, m.AutoTrimConfig.Enable.ValueBool())
+		if !m.AutoTrimConfig.RetainedRows.IsNull() {
+			config += fmt.Sprintf(`
+			retained_rows = %d`, m.AutoTrimConfig.RetainedRows.ValueInt64())
+		}
+		if !m.AutoTrimConfig.RetainedLifetimeDuration.IsNull() {
+			config += fmt.Sprintf(`
+			retained_lifetime_duration = %d`, m.AutoTrimConfig.RetainedLifetimeDuration.ValueInt64())
+		}
+		config += `
+		}`
+	}
+
+	if m.StaticExportConfig != nil {
+		config += `
+		static_export_config = {`
+		for k, v := range m.StaticExportConfig {
+			config += fmt.Sprintf(`
+			%q = {
+				export_directory = %q
+				export_period    = %d
+			}`, k, v.ExportDirectory.ValueString(), v.ExportPeriod.ValueInt64())
+		}
+		config += `
+		}`
+	}
+
+	if !m.QueueAgentStage.IsNull() {
+		config += fmt.Sprintf(`
+		queue_agent_stage = %q`, m.QueueAgentStage.ValueString())
+	}
+
+	config += `
+	}`
+
+	return config
+}
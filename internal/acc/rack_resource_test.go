@@ -0,0 +1,99 @@
+package acc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+
+	"terraform-provider-ytsaurus/internal/resource/rack"
+)
+
+func TestRackResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "test_rack"
+	testDataCenterName := "test_rack_data_center"
+	testRackName := "test_rack"
+	testRackPath := fmt.Sprintf("//sys/racks/%s", testRackName)
+
+	var hosts []string
+	if err := testYTClient.ListNode(ctx, ypath.Path("//sys/hosts"), &hosts, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) == 0 {
+		t.Fatal("no hosts are registered")
+	}
+	testHost := hosts[0]
+	testHostPath := fmt.Sprintf("//sys/hosts/%s", testHost)
+
+	configCreate := rack.RackModel{
+		Name: types.StringValue(testRackName),
+	}
+
+	configUpdate := rack.RackModel{
+		Name:       types.StringValue(testRackName),
+		DataCenter: types.StringValue(testDataCenterName),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			accCheckYTsaurusObjectDestroyed(testRackPath),
+			accCheckYTsaurusObjectDestroyed(fmt.Sprintf("//sys/data_centers/%s", testDataCenterName)),
+			accCheckYTsaurusObjectDestroyed(fmt.Sprintf("%s/@rack", testHostPath)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusRackConfig(resourceID, testDataCenterName, testHost, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testRackPath, "type", "rack"),
+					accCheckYTsaurusStringAttribute(testHostPath, "rack", testRackName),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusRackConfig(resourceID, testDataCenterName, testHost, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testRackPath, "data_center", testDataCenterName),
+				),
+			},
+			{
+				ResourceName:      fmt.Sprintf("ytsaurus_rack.%s", resourceID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      fmt.Sprintf("ytsaurus_host_rack.%s", resourceID),
+				ImportState:       true,
+				ImportStateId:     testHost,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusRackConfig(id, dataCenter, host string, m rack.RackModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_data_center" %q {
+		name = %q
+	}
+
+	resource "ytsaurus_rack" %q {
+		name = %q`, id, dataCenter, id, m.Name.ValueString())
+
+	if !m.DataCenter.IsNull() {
+		config += fmt.Sprintf(`
+		data_center = ytsaurus_data_center.%s.name`, id)
+	}
+
+	config += fmt.Sprintf(`
+	}
+
+	resource "ytsaurus_host_rack" %q {
+		host = %q
+		rack = ytsaurus_rack.%s.name
+	}`, id, host, id)
+
+	return config
+}
@@ -0,0 +1,174 @@
+package acc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/replicatedtable"
+	"terraform-provider-ytsaurus/internal/resource/tablereplica"
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+)
+
+func TestReplicatedTableResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "fakereplicatedtable"
+	replicaResourceID := "fakereplicatedtablereplica"
+	testTablePath := "//tmp/fakereplicatedtable"
+	testReplicaPath := "//tmp/fakereplicatedtable_replica"
+	testReplicaCluster := "primary"
+
+	// unique_keys is not set, it defaults to true for sorted tables.
+	sortedSchema := &tableschema.TableSchemaModel{
+		Columns: []tableschema.ColumnModel{
+			{
+				Name:      types.StringValue("key"),
+				Type:      types.StringValue("string"),
+				SortOrder: types.StringValue("ascending"),
+			},
+			{
+				Name: types.StringValue("value"),
+				Type: types.StringValue("string"),
+			},
+		},
+	}
+
+	sortedSchemaNotUnique := &tableschema.TableSchemaModel{
+		UniqueKeys: types.BoolValue(false),
+		Columns:    sortedSchema.Columns,
+	}
+
+	configNotUnique := replicatedtable.ReplicatedTableModel{
+		Path:   types.StringValue(testTablePath),
+		Schema: sortedSchemaNotUnique,
+	}
+
+	configCreate := replicatedtable.ReplicatedTableModel{
+		Path:   types.StringValue(testTablePath),
+		Schema: sortedSchema,
+	}
+
+	configUpdate := replicatedtable.ReplicatedTableModel{
+		Path:   types.StringValue(testTablePath),
+		Schema: sortedSchema,
+		State:  types.StringValue(yt.TabletUnmounted),
+		ReplicatedTableOptions: &replicatedtable.ReplicatedTableOptionsModel{
+			EnableReplicatedTableTracker: types.BoolValue(true),
+			MaxSyncReplicaCount:          types.Int64Value(1),
+		},
+	}
+
+	replicaCreate := tablereplica.TableReplicaModel{
+		TablePath:   types.StringValue(testTablePath),
+		ClusterName: types.StringValue(testReplicaCluster),
+		ReplicaPath: types.StringValue(testReplicaPath),
+	}
+
+	replicaUpdate := tablereplica.TableReplicaModel{
+		TablePath:   types.StringValue(testTablePath),
+		ClusterName: types.StringValue(testReplicaCluster),
+		ReplicaPath: types.StringValue(testReplicaPath),
+		Mode:        types.StringValue(string(yt.SyncMode)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testTablePath),
+		Steps: []resource.TestStep{
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusReplicatedTableConfig(resourceID, configNotUnique),
+				ExpectError: regexp.MustCompile(`Sorted dynamic tables require unique_keys to be true`),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() +
+					accResourceYtsaurusReplicatedTableConfig(resourceID, configCreate) +
+					accResourceYtsaurusTableReplicaConfig(replicaResourceID, resourceID, replicaCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testTablePath, "type", string(yt.NodeReplicatedTable)),
+					accCheckYTsaurusStringAttribute(testTablePath, "tablet_state", yt.TabletMounted),
+					accCheckYTsaurusBoolAttribute(testTablePath, "schema/@unique_keys", true),
+					resource.TestCheckResourceAttr("ytsaurus_replicated_table."+resourceID, "schema.unique_keys", "true"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() +
+					accResourceYtsaurusReplicatedTableConfig(resourceID, configUpdate) +
+					accResourceYtsaurusTableReplicaConfig(replicaResourceID, resourceID, replicaUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testTablePath, "tablet_state", yt.TabletUnmounted),
+					accCheckYTsaurusBoolAttribute(testTablePath, "replicated_table_options/enable_replicated_table_tracker", true),
+					accCheckYTsaurusInt64Attribute(testTablePath, "replicated_table_options/max_sync_replica_count", 1),
+				),
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusReplicatedTableConfig(id string, m replicatedtable.ReplicatedTableModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_replicated_table" %q {`, id)
+
+	if !m.Path.IsNull() {
+		config += fmt.Sprintf(`
+		path = %q`, m.Path.ValueString())
+	}
+
+	if m.Schema != nil {
+		config += accAddTableSchemaConfig(m.Schema)
+	}
+
+	if !m.State.IsNull() {
+		config += fmt.Sprintf(`
+		state = %q`, m.State.ValueString())
+	}
+
+	if o := m.ReplicatedTableOptions; o != nil {
+		config += `
+		replicated_table_options = {`
+		if !o.EnableReplicatedTableTracker.IsNull() {
+			config += fmt.Sprintf(`
+			enable_replicated_table_tracker = %t`, o.EnableReplicatedTableTracker.ValueBool())
+		}
+		if !o.MinSyncReplicaCount.IsNull() {
+			config += fmt.Sprintf(`
+			min_sync_replica_count = %d`, o.MinSyncReplicaCount.ValueInt64())
+		}
+		if !o.MaxSyncReplicaCount.IsNull() {
+			config += fmt.Sprintf(`
+			max_sync_replica_count = %d`, o.MaxSyncReplicaCount.ValueInt64())
+		}
+		config += `
+		}`
+	}
+
+	config += `
+	}`
+
+	return config
+}
+
+func accResourceYtsaurusTableReplicaConfig(id, tableID string, m tablereplica.TableReplicaModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_table_replica" %q {
+		table_path   = ytsaurus_replicated_table.%s.path
+		cluster_name = %q
+		replica_path = %q`, id, tableID, m.ClusterName.ValueString(), m.ReplicaPath.ValueString())
+
+	if !m.Mode.IsNull() {
+		config += fmt.Sprintf(`
+		mode = %q`, m.Mode.ValueString())
+	}
+
+	if !m.Enabled.IsNull() {
+		config += fmt.Sprintf(`
+		enabled = %t`, m.Enabled.ValueBool())
+	}
+
+	config += `
+	}`
+
+	return config
+}
@@ -0,0 +1,145 @@
+package acc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/schedulerpooltree"
+)
+
+func TestSchedulerPoolTreeResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "fakepooltree"
+	testPoolTreeName := resourceID
+	testPoolTreeYTCypressPath := fmt.Sprintf("//sys/pool_trees/%s", testPoolTreeName)
+	testPoolName := "fakepooltreepool"
+	testNodesFilter := "fakepooltree"
+	testStarvationTimeout := int64(30000)
+	testMaxEphemeralPoolsPerUser := int64(5)
+
+	testACL := []yt.ACE{
+		{
+			Action:      yt.ActionAllow,
+			Subjects:    []string{"users"},
+			Permissions: []string{yt.PermissionUse},
+		},
+	}
+
+	configCreate := schedulerpooltree.SchedulerPoolTreeModel{
+		Name: types.StringValue(testPoolTreeName),
+		Config: &schedulerpooltree.SchedulerPoolTreeConfigModel{
+			NodesFilter:  types.StringValue(testNodesFilter),
+			MainResource: types.StringValue("cpu"),
+		},
+	}
+
+	configUpdate := schedulerpooltree.SchedulerPoolTreeModel{
+		Name: types.StringValue(testPoolTreeName),
+		Config: &schedulerpooltree.SchedulerPoolTreeConfigModel{
+			NodesFilter:                types.StringValue(testNodesFilter),
+			FairShareStarvationTimeout: types.Int64Value(testStarvationTimeout),
+			MaxEphemeralPoolsPerUser:   types.Int64Value(testMaxEphemeralPoolsPerUser),
+		},
+		ACL: acl.ToACLModel(testACL),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testPoolTreeYTCypressPath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusSchedulerPoolTreeConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testPoolTreeYTCypressPath, "config/nodes_filter", testNodesFilter),
+					accCheckYTsaurusStringAttribute(testPoolTreeYTCypressPath, "config/main_resource", "cpu"),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusSchedulerPoolTreeConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute(testPoolTreeYTCypressPath, "config/fair_share_starvation_timeout", testStarvationTimeout),
+					accCheckYTsaurusInt64Attribute(testPoolTreeYTCypressPath, "config/max_ephemeral_pools_per_user", testMaxEphemeralPoolsPerUser),
+					accCheckYTsaurusACLAttribute(testPoolTreeYTCypressPath, testACL),
+				),
+			},
+			{
+				PreConfig: func() {
+					_, err := testYTClient.CreateObject(ctx, yt.NodeSchedulerPool, &yt.CreateObjectOptions{
+						Attributes: map[string]interface{}{
+							"name":      testPoolName,
+							"pool_tree": testPoolTreeName,
+						},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusSchedulerPoolTreeConfig(resourceID, configUpdate),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`still has pools`),
+			},
+			{
+				PreConfig: func() {
+					p := ypath.Path(testPoolTreeYTCypressPath).Child(testPoolName)
+					if err := testYTClient.RemoveNode(ctx, p, nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusSchedulerPoolTreeConfig(resourceID, configUpdate),
+			},
+		},
+	})
+}
+
+func accResourceYtsaurusSchedulerPoolTreeConfig(id string, m schedulerpooltree.SchedulerPoolTreeModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_scheduler_pool_tree" %q {
+		name = %q`, id, m.Name.ValueString())
+
+	if c := m.Config; c != nil {
+		config += `
+		config = {`
+		if !c.NodesFilter.IsNull() {
+			config += fmt.Sprintf(`
+			nodes_filter = %q`, c.NodesFilter.ValueString())
+		}
+		if !c.DefaultParentPool.IsNull() {
+			config += fmt.Sprintf(`
+			default_parent_pool = %q`, c.DefaultParentPool.ValueString())
+		}
+		if !c.FairShareStarvationTimeout.IsNull() {
+			config += fmt.Sprintf(`
+			fair_share_starvation_timeout = %d`, c.FairShareStarvationTimeout.ValueInt64())
+		}
+		if !c.FairShareStarvationTimeoutLimit.IsNull() {
+			config += fmt.Sprintf(`
+			fair_share_starvation_timeout_limit = %d`, c.FairShareStarvationTimeoutLimit.ValueInt64())
+		}
+		if !c.MainResource.IsNull() {
+			config += fmt.Sprintf(`
+			main_resource = %q`, c.MainResource.ValueString())
+		}
+		if !c.MaxEphemeralPoolsPerUser.IsNull() {
+			config += fmt.Sprintf(`
+			max_ephemeral_pools_per_user = %d`, c.MaxEphemeralPoolsPerUser.ValueInt64())
+		}
+		config += `
+		}`
+	}
+
+	acl, _ := acl.ToYTsaurusACL(m.ACL)
+	if len(acl) > 0 {
+		config += accAddACLConfig(acl)
+	}
+
+	config += `
+	}`
+
+	return config
+}
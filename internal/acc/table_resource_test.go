@@ -0,0 +1,186 @@
+package acc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/table"
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+)
+
+func TestTableResourceCreateAndUpdate(t *testing.T) {
+	resourceID := "fakeprojecttable"
+	testTablePath := "//tmp/fakeprojecttable"
+	testTableTmpAccount := "tmp"
+	testCompressionCodec := "zstd_3"
+	testOptimizeFor := "scan"
+	testReplicationFactor := int64(1)
+
+	testACL := []yt.ACE{
+		{
+			Action:          yt.ActionAllow,
+			Subjects:        []string{"users"},
+			Permissions:     []string{yt.PermissionRead},
+			InheritanceMode: "object_and_descendants",
+		},
+	}
+
+	testSchema := &tableschema.TableSchemaModel{
+		Columns: []tableschema.ColumnModel{
+			{
+				Name:      types.StringValue("key"),
+				Type:      types.StringValue("string"),
+				SortOrder: types.StringValue("ascending"),
+				Required:  types.BoolValue(true),
+			},
+			{
+				Name: types.StringValue("value"),
+				Type: types.StringValue("any"),
+			},
+		},
+	}
+
+	testSchemaUpdated := &tableschema.TableSchemaModel{
+		Columns: append(testSchema.Columns, tableschema.ColumnModel{
+			Name: types.StringValue("comment"),
+			Type: types.StringValue("utf8"),
+		}),
+	}
+
+	configEmpty := table.TableModel{}
+
+	configCreate := table.TableModel{
+		Path:   types.StringValue(testTablePath),
+		Schema: testSchema,
+	}
+
+	configUpdate := table.TableModel{
+		Path:              types.StringValue(testTablePath),
+		Schema:            testSchemaUpdated,
+		CompressionCodec:  types.StringValue(testCompressionCodec),
+		OptimizeFor:       types.StringValue(testOptimizeFor),
+		ReplicationFactor: types.Int64Value(testReplicationFactor),
+		Account:           types.StringValue(testTableTmpAccount),
+		ACL:               acl.ToACLModel(testACL),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testTablePath),
+		Steps: []resource.TestStep{
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusTableConfig(resourceID, configEmpty),
+				ExpectError: regexp.MustCompile(`The argument "path" is required, but no definition was found.`),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusTableConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testTablePath, "type", string(yt.NodeTable)),
+					accCheckYTsaurusStringAttribute(testTablePath, "schema/0/name", "key"),
+					accCheckYTsaurusStringAttribute(testTablePath, "schema/0/sort_order", "ascending"),
+					accCheckYTsaurusBoolAttribute(testTablePath, "schema/@strict", true),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusTableConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testTablePath, "schema/2/name", "comment"),
+					accCheckYTsaurusStringAttribute(testTablePath, "compression_codec", testCompressionCodec),
+					accCheckYTsaurusStringAttribute(testTablePath, "optimize_for", testOptimizeFor),
+					accCheckYTsaurusInt64Attribute(testTablePath, "replication_factor", testReplicationFactor),
+					accCheckYTsaurusStringAttribute(testTablePath, "account", testTableTmpAccount),
+					accCheckYTsaurusACLAttribute(testTablePath, testACL),
+				),
+			},
+		},
+	})
+}
+
+func accAddTableSchemaConfig(s *tableschema.TableSchemaModel) string {
+	config := `
+		schema = {`
+
+	if !s.Strict.IsNull() {
+		config += fmt.Sprintf(`
+			strict = %t`, s.Strict.ValueBool())
+	}
+
+	if !s.UniqueKeys.IsNull() {
+		config += fmt.Sprintf(`
+			unique_keys = %t`, s.UniqueKeys.ValueBool())
+	}
+
+	config += `
+			columns = [`
+	for _, c := range s.Columns {
+		config += fmt.Sprintf(`
+				{
+					name = %q
+					type = %q`, c.Name.ValueString(), c.Type.ValueString())
+		if !c.SortOrder.IsNull() {
+			config += fmt.Sprintf(`
+					sort_order = %q`, c.SortOrder.ValueString())
+		}
+		if !c.Required.IsNull() {
+			config += fmt.Sprintf(`
+					required = %t`, c.Required.ValueBool())
+		}
+		config += `
+				},`
+	}
+	config += `
+			]
+		}`
+
+	return config
+}
+
+func accResourceYtsaurusTableConfig(id string, m table.TableModel) string {
+	config := fmt.Sprintf(`
+	resource "ytsaurus_table" %q {`, id)
+
+	if !m.Path.IsNull() {
+		config += fmt.Sprintf(`
+		path = %q`, m.Path.ValueString())
+	}
+
+	if m.Schema != nil {
+		config += accAddTableSchemaConfig(m.Schema)
+	}
+
+	if !m.CompressionCodec.IsNull() {
+		config += fmt.Sprintf(`
+		compression_codec = %q`, m.CompressionCodec.ValueString())
+	}
+
+	if !m.OptimizeFor.IsNull() {
+		config += fmt.Sprintf(`
+		optimize_for = %q`, m.OptimizeFor.ValueString())
+	}
+
+	if !m.ReplicationFactor.IsNull() {
+		config += fmt.Sprintf(`
+		replication_factor = %d`, m.ReplicationFactor.ValueInt64())
+	}
+
+	if !m.Account.IsNull() {
+		config += fmt.Sprintf(`
+		account = %q`, m.Account.ValueString())
+	}
+
+	acl, _ := acl.ToYTsaurusACL(m.ACL)
+	if len(acl) > 0 {
+		config += accAddACLConfig(acl)
+	}
+
+	config += `
+	}`
+
+	return config
+}
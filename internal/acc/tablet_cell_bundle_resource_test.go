@@ -228,6 +228,114 @@ func TestTabletCellBundleResourceCreateWithAllOptions(t *testing.T) {
 
 }
 
+func TestTabletCellBundleResourceQuotaAndTargetConfig(t *testing.T) {
+	resourceID := "fakebundle"
+	testTabletCellBundleName := resourceID
+	testtestTabletCellBundleYTCypressPath := fmt.Sprintf("//sys/tablet_cell_bundles/%s", testTabletCellBundleName)
+
+	options := &tabletcellbundle.TabletCellBundleOptionsModel{
+		ChangelogAccount:           types.StringValue("tmp"),
+		SnapshotAccount:            types.StringValue("tmp"),
+		ChangelogPrimaryMedium:     types.StringValue("default"),
+		SnapshotPrimaryMedium:      types.StringValue("default"),
+		ChangelogWriteQuorum:       types.Int64Value(1),
+		ChangelogReadQuorum:        types.Int64Value(1),
+		ChangelogReplicationFactor: types.Int64Value(1),
+		SnapshotReplicationFactor:  types.Int64Value(1),
+	}
+
+	targetConfig := func(nodeCount, nodeMemory, tabletStatic int64) *tabletcellbundle.TabletCellBundleTargetConfigModel {
+		return &tabletcellbundle.TabletCellBundleTargetConfigModel{
+			TabletNodeCount: types.Int64Value(nodeCount),
+			RPCProxyCount:   types.Int64Null(),
+			TabletNodeResourceGuarantee: &tabletcellbundle.TabletCellBundleInstanceResourcesModel{
+				VCPU:   types.Int64Value(4000),
+				Memory: types.Int64Value(nodeMemory),
+				Type:   types.StringNull(),
+			},
+			MemoryLimits: &tabletcellbundle.TabletCellBundleMemoryLimitsModel{
+				TabletStatic:           types.Int64Value(tabletStatic),
+				TabletDynamic:          types.Int64Value(1 << 30),
+				CompressedBlockCache:   types.Int64Null(),
+				UncompressedBlockCache: types.Int64Null(),
+				VersionedChunkMeta:     types.Int64Null(),
+				LookupRowCache:         types.Int64Null(),
+				Reserved:               types.Int64Null(),
+			},
+		}
+	}
+
+	configMemoryLimitsOverflow := tabletcellbundle.TabletCellBundleModel{
+		Name:                         types.StringValue(testTabletCellBundleName),
+		TabletCellCount:              types.Int64Value(0),
+		Options:                      options,
+		BundleControllerTargetConfig: targetConfig(1, 2<<30, 2<<30),
+	}
+
+	configQuotaOverflow := tabletcellbundle.TabletCellBundleModel{
+		Name:            types.StringValue(testTabletCellBundleName),
+		TabletCellCount: types.Int64Value(0),
+		Options:         options,
+		ResourceQuota: &tabletcellbundle.TabletCellBundleResourceQuotaModel{
+			CPU:    types.Int64Value(8),
+			Memory: types.Int64Value(4 << 30),
+		},
+		BundleControllerTargetConfig: targetConfig(3, 2<<30, 1<<30),
+	}
+
+	configCreate := tabletcellbundle.TabletCellBundleModel{
+		Name:            types.StringValue(testTabletCellBundleName),
+		TabletCellCount: types.Int64Value(0),
+		Options:         options,
+		ResourceQuota: &tabletcellbundle.TabletCellBundleResourceQuotaModel{
+			CPU:    types.Int64Value(8),
+			Memory: types.Int64Value(8 << 30),
+		},
+		BundleControllerTargetConfig: targetConfig(2, 2<<30, 1<<30),
+	}
+
+	configUpdate := tabletcellbundle.TabletCellBundleModel{
+		Name:            types.StringValue(testTabletCellBundleName),
+		TabletCellCount: types.Int64Value(0),
+		Options:         options,
+		ResourceQuota: &tabletcellbundle.TabletCellBundleResourceQuotaModel{
+			CPU:    types.Int64Value(16),
+			Memory: types.Int64Value(16 << 30),
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testtestTabletCellBundleYTCypressPath),
+		Steps: []resource.TestStep{
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusTabletCellBundleConfig(resourceID, configMemoryLimitsOverflow),
+				ExpectError: regexp.MustCompile(`Sum of memory_limits \d+ exceeds tablet_node_resource_guarantee.memory`),
+			},
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusTabletCellBundleConfig(resourceID, configQuotaOverflow),
+				ExpectError: regexp.MustCompile(`exceeds resource_quota.memory`),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusTabletCellBundleConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute(testtestTabletCellBundleYTCypressPath, "resource_quota/cpu", 8),
+					accCheckYTsaurusInt64Attribute(testtestTabletCellBundleYTCypressPath, "resource_quota/memory", 8<<30),
+					accCheckYTsaurusInt64Attribute(testtestTabletCellBundleYTCypressPath, "bundle_controller_target_config/tablet_node_count", 2),
+					accCheckYTsaurusInt64Attribute(testtestTabletCellBundleYTCypressPath, "bundle_controller_target_config/memory_limits/tablet_static", 1<<30),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusTabletCellBundleConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute(testtestTabletCellBundleYTCypressPath, "resource_quota/cpu", 16),
+					accCheckYTsaurusObjectDestroyed(fmt.Sprintf("%s/@bundle_controller_target_config", testtestTabletCellBundleYTCypressPath)),
+				),
+			},
+		},
+	})
+}
+
 func accResourceYtsaurusTabletCellBundleConfig(id string, m tabletcellbundle.TabletCellBundleModel) string {
 
 	config := fmt.Sprintf(`
@@ -301,8 +409,52 @@ func accResourceYtsaurusTabletCellBundleConfig(id string, m tabletcellbundle.Tab
 		}`
 	}
 
+	if m.ResourceQuota != nil {
+		config += fmt.Sprintf(`
+		resource_quota = {
+			cpu    = %d
+			memory = %d
+		}`, m.ResourceQuota.CPU.ValueInt64(), m.ResourceQuota.Memory.ValueInt64())
+	}
+
+	if c := m.BundleControllerTargetConfig; c != nil {
+		config += `
+		bundle_controller_target_config = {`
+		config += accAddOptionalInt64Config("tablet_node_count", c.TabletNodeCount)
+		config += accAddOptionalInt64Config("rpc_proxy_count", c.RPCProxyCount)
+
+		if g := c.TabletNodeResourceGuarantee; g != nil {
+			config += `
+			tablet_node_resource_guarantee = {`
+			config += accAddOptionalInt64Config("vcpu", g.VCPU)
+			config += accAddOptionalInt64Config("memory", g.Memory)
+			config += `
+			}`
+		}
+
+		if l := c.MemoryLimits; l != nil {
+			config += `
+			memory_limits = {`
+			config += accAddOptionalInt64Config("tablet_static", l.TabletStatic)
+			config += accAddOptionalInt64Config("tablet_dynamic", l.TabletDynamic)
+			config += `
+			}`
+		}
+
+		config += `
+		}`
+	}
+
 	config += `
 	}`
 
 	return config
 }
+
+func accAddOptionalInt64Config(name string, v types.Int64) string {
+	if v.IsNull() {
+		return ""
+	}
+	return fmt.Sprintf(`
+			%s = %d`, name, v.ValueInt64())
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.ytsaurus.tech/yt/go/ypath"
 
 	"terraform-provider-ytsaurus/internal/resource/user"
 )
@@ -102,6 +104,135 @@ func TestUserResourceCreateWithAllOptions(t *testing.T) {
 	})
 }
 
+func TestUserResourceThrottling(t *testing.T) {
+	resourceID := "testuser_throttling"
+	testUserName := resourceID
+	testUserYTCypressPath := fmt.Sprintf("//sys/users/%s", testUserName)
+
+	toLimit := func(v int64) types.Object {
+		return types.ObjectValueMust(
+			map[string]attr.Type{
+				"default":  types.Int64Type,
+				"per_cell": types.MapType{ElemType: types.Int64Type},
+			},
+			map[string]attr.Value{
+				"default":  types.Int64Value(v),
+				"per_cell": types.MapNull(types.Int64Type),
+			},
+		)
+	}
+
+	configCreate := user.UserModel{
+		Name:                  types.StringValue(testUserName),
+		Banned:                types.BoolValue(true),
+		ReadRequestRateLimit:  toLimit(300),
+		WriteRequestRateLimit: toLimit(200),
+		RequestQueueSizeLimit: toLimit(50),
+	}
+
+	configUpdate := user.UserModel{
+		Name:                  types.StringValue(testUserName),
+		Banned:                types.BoolValue(false),
+		ReadRequestRateLimit:  toLimit(400),
+		WriteRequestRateLimit: toLimit(200),
+		RequestQueueSizeLimit: toLimit(150),
+	}
+
+	configReset := user.UserModel{
+		Name: types.StringValue(testUserName),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testUserYTCypressPath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusUserConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusBoolAttribute(testUserYTCypressPath, "banned", true),
+					accCheckYTsaurusInt64Attribute(testUserYTCypressPath, "request_limits/read_request_rate/default", 300),
+					accCheckYTsaurusInt64Attribute(testUserYTCypressPath, "request_limits/write_request_rate/default", 200),
+					accCheckYTsaurusInt64Attribute(testUserYTCypressPath, "request_limits/request_queue_size/default", 50),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusUserConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusBoolAttribute(testUserYTCypressPath, "banned", false),
+					accCheckYTsaurusInt64Attribute(testUserYTCypressPath, "request_limits/read_request_rate/default", 400),
+					accCheckYTsaurusInt64Attribute(testUserYTCypressPath, "request_limits/request_queue_size/default", 150),
+				),
+			},
+			{
+				// Limits changed outside of Terraform are reported as drift.
+				PreConfig: func() {
+					p := ypath.Path(testUserYTCypressPath).Attr("request_limits/request_queue_size/default")
+					if err := testYTClient.SetNode(ctx, p, 10, nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             accGetYTLocalDockerProviderConfig() + accResourceYtsaurusUserConfig(resourceID, configUpdate),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Limits removed from the config are reset to the cluster defaults.
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusUserConfig(resourceID, configReset),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusInt64Attribute(testUserYTCypressPath, "request_limits/read_request_rate/default", 100),
+					accCheckYTsaurusInt64Attribute(testUserYTCypressPath, "request_limits/write_request_rate/default", 100),
+					accCheckYTsaurusInt64Attribute(testUserYTCypressPath, "request_limits/request_queue_size/default", 100),
+				),
+			},
+		},
+	})
+}
+
+func TestUserResourcePassword(t *testing.T) {
+	resourceID := "testuser_password"
+	testUserName := resourceID
+	testUserYTCypressPath := fmt.Sprintf("//sys/users/%s", testUserName)
+
+	configCreate := user.UserModel{
+		Name:     types.StringValue(testUserName),
+		Password: types.StringValue("initial-password"),
+	}
+
+	configUpdate := user.UserModel{
+		Name:     types.StringValue(testUserName),
+		Password: types.StringValue("rotated-password"),
+	}
+
+	configConflict := user.UserModel{
+		Name:           types.StringValue(testUserName),
+		Password:       types.StringValue("rotated-password"),
+		PasswordSHA256: types.StringValue("5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             accCheckYTsaurusObjectDestroyed(testUserYTCypressPath),
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusUserConfig(resourceID, configCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testUserYTCypressPath, "name", testUserName),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusUserConfig(resourceID, configUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusStringAttribute(testUserYTCypressPath, "name", testUserName),
+				),
+			},
+			{
+				Config:      accGetYTLocalDockerProviderConfig() + accResourceYtsaurusUserConfig(resourceID, configConflict),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 // func accResourceYtsaurusUserConfig(resource, id, name string, memberOf []string) string {
 func accResourceYtsaurusUserConfig(id string, m user.UserModel) string {
 	config := fmt.Sprintf(`
@@ -125,6 +256,52 @@ func accResourceYtsaurusUserConfig(id string, m user.UserModel) string {
 		]`
 	}
 
+	if !m.Password.IsNull() {
+		config += fmt.Sprintf(`
+		password = %q`, m.Password.ValueString())
+	}
+
+	if !m.PasswordSHA256.IsNull() {
+		config += fmt.Sprintf(`
+		password_sha256 = %q`, m.PasswordSHA256.ValueString())
+	}
+
+	if !m.Banned.IsNull() {
+		config += fmt.Sprintf(`
+		banned = %t`, m.Banned.ValueBool())
+	}
+
+	limits := []struct {
+		name  string
+		value types.Object
+	}{
+		{"read_request_rate_limit", m.ReadRequestRateLimit},
+		{"write_request_rate_limit", m.WriteRequestRateLimit},
+		{"request_queue_size_limit", m.RequestQueueSizeLimit},
+	}
+	for _, l := range limits {
+		if l.value.IsNull() {
+			continue
+		}
+		var limit user.UserRequestLimitModel
+		l.value.As(ctx, &limit, basetypes.ObjectAsOptions{})
+		config += fmt.Sprintf(`
+		%s = {
+			default = %d`, l.name, limit.Default.ValueInt64())
+		if !limit.PerCell.IsNull() {
+			config += `
+			per_cell = {`
+			for k, v := range limit.PerCell.Elements() {
+				config += fmt.Sprintf(`
+				%q = %d`, k, v.(types.Int64).ValueInt64())
+			}
+			config += `
+			}`
+		}
+		config += `
+		}`
+	}
+
 	config += `
 	}`
 
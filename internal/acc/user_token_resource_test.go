@@ -0,0 +1,88 @@
+package acc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+)
+
+func TestUserTokenResource(t *testing.T) {
+	resourceID := "test_user_token"
+	testUserName := "test_user_token_robot"
+	resourceName := fmt.Sprintf("ytsaurus_user_token.%s", resourceID)
+
+	var issuedTokenSHA256 string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return accCheckYTsaurusObjectDestroyed(fmt.Sprintf("//sys/cypress_tokens/%s", issuedTokenSHA256))(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusUserTokenConfig(resourceID, testUserName, "ci", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					accCheckYTsaurusUserTokenIssued(resourceName, testUserName, "ci", &issuedTokenSHA256),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusUserTokenConfig(resourceID, testUserName, "ci updated", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					accCheckYTsaurusUserTokenIssued(resourceName, testUserName, "ci updated", &issuedTokenSHA256),
+				),
+			},
+			{
+				Config: accGetYTLocalDockerProviderConfig() + accResourceYtsaurusUserTokenConfig(resourceID, testUserName, "ci updated", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						// The rotated token must be revoked.
+						return accCheckYTsaurusObjectDestroyed(fmt.Sprintf("//sys/cypress_tokens/%s", issuedTokenSHA256))(s)
+					},
+					accCheckYTsaurusUserTokenIssued(resourceName, testUserName, "ci updated", &issuedTokenSHA256),
+				),
+			},
+		},
+	})
+}
+
+func accCheckYTsaurusUserTokenIssued(resourceName, user, description string, tokenSHA256 *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in the state", resourceName)
+		}
+
+		sum := sha256.Sum256([]byte(rs.Primary.Attributes["token"]))
+		if hex.EncodeToString(sum[:]) != rs.Primary.Attributes["token_sha256"] {
+			return fmt.Errorf("token_sha256 doesn't match the token")
+		}
+		*tokenSHA256 = rs.Primary.Attributes["token_sha256"]
+
+		p := ypath.Path(fmt.Sprintf("//sys/cypress_tokens/%s", *tokenSHA256))
+		if err := accCheckYTsaurusStringAttribute(p.String(), "user", user)(s); err != nil {
+			return err
+		}
+		return accCheckYTsaurusStringAttribute(p.String(), "description", description)(s)
+	}
+}
+
+func accResourceYtsaurusUserTokenConfig(id, user, description, rotation string) string {
+	return fmt.Sprintf(`
+	resource "ytsaurus_user" %q {
+		name = %q
+	}
+
+	resource "ytsaurus_user_token" %q {
+		user        = ytsaurus_user.%s.name
+		description = %q
+		rotation = {
+			version = %q
+		}
+	}`, id, user, id, id, description, rotation)
+}
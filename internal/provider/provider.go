@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -12,16 +11,39 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"go.ytsaurus.tech/yt/go/yt"
-	"go.ytsaurus.tech/yt/go/yt/ythttp"
-
+	"terraform-provider-ytsaurus/internal/resource/accesscontrolobject"
+	"terraform-provider-ytsaurus/internal/resource/accesscontrolobjectnamespace"
 	"terraform-provider-ytsaurus/internal/resource/account"
+	"terraform-provider-ytsaurus/internal/resource/chytclique"
+	"terraform-provider-ytsaurus/internal/resource/clusternode"
+	"terraform-provider-ytsaurus/internal/resource/cypressattribute"
+	"terraform-provider-ytsaurus/internal/resource/datacenter"
+	"terraform-provider-ytsaurus/internal/resource/document"
+	"terraform-provider-ytsaurus/internal/resource/dynamicconfig"
+	"terraform-provider-ytsaurus/internal/resource/dynamictable"
+	"terraform-provider-ytsaurus/internal/resource/file"
 	"terraform-provider-ytsaurus/internal/resource/group"
+	"terraform-provider-ytsaurus/internal/resource/groupmembership"
+	"terraform-provider-ytsaurus/internal/resource/hostrack"
+	"terraform-provider-ytsaurus/internal/resource/link"
 	"terraform-provider-ytsaurus/internal/resource/mapnode"
 	"terraform-provider-ytsaurus/internal/resource/medium"
+	"terraform-provider-ytsaurus/internal/resource/networkproject"
+	"terraform-provider-ytsaurus/internal/resource/portal"
+	"terraform-provider-ytsaurus/internal/resource/proxyrole"
+	"terraform-provider-ytsaurus/internal/resource/proxyroleassignment"
+	"terraform-provider-ytsaurus/internal/resource/queue"
+	"terraform-provider-ytsaurus/internal/resource/queueconsumerregistration"
+	"terraform-provider-ytsaurus/internal/resource/rack"
+	"terraform-provider-ytsaurus/internal/resource/replicatedtable"
 	"terraform-provider-ytsaurus/internal/resource/schedulerpool"
+	"terraform-provider-ytsaurus/internal/resource/schedulerpooltree"
+	"terraform-provider-ytsaurus/internal/resource/table"
+	"terraform-provider-ytsaurus/internal/resource/tablereplica"
 	"terraform-provider-ytsaurus/internal/resource/tabletcellbundle"
 	"terraform-provider-ytsaurus/internal/resource/user"
+	"terraform-provider-ytsaurus/internal/resource/usertoken"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
 )
 
 type ytsaurusProvider struct{}
@@ -89,26 +111,14 @@ func (p *ytsaurusProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	clientConfig := &yt.Config{
-		Proxy:  cleanUpPrefix(config.Cluster.ValueString()),
-		UseTLS: config.UseTLS.ValueBool() || strings.HasPrefix(config.Cluster.ValueString(), HTTPS),
-	}
-
-	if !config.CertificateAuthorityCertificate.IsNull() {
-		clientConfig.CertificateAuthorityData = []byte(config.CertificateAuthorityCertificate.ValueString())
+	clientConfig := ytsaurus.ClientConfig{
+		Proxy:         cleanUpPrefix(config.Cluster.ValueString()),
+		Token:         config.Token.ValueString(),
+		UseTLS:        config.UseTLS.ValueBool() || strings.HasPrefix(config.Cluster.ValueString(), HTTPS),
+		CACertificate: config.CertificateAuthorityCertificate.ValueString(),
 	}
 
-	if !config.Token.IsNull() {
-		clientConfig.Token = config.Token.ValueString()
-	} else {
-		if token := os.Getenv("YT_TOKEN"); token != "" {
-			clientConfig.Token = token
-		} else {
-			clientConfig.ReadTokenFromFile = true
-		}
-	}
-
-	client, err := ythttp.NewClient(clientConfig)
+	client, err := ytsaurus.NewClient(clientConfig.YTConfig())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create YTsaurus Client",
@@ -117,6 +127,7 @@ func (p *ytsaurusProvider) Configure(ctx context.Context, req provider.Configure
 				err.Error(),
 			),
 		)
+		return
 	}
 
 	resp.ResourceData = client
@@ -130,11 +141,36 @@ func (p *ytsaurusProvider) DataSources(_ context.Context) []func() datasource.Da
 func (p *ytsaurusProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		group.NewGroupResource,
+		groupmembership.NewGroupMembershipResource,
 		user.NewUserResource,
+		usertoken.NewUserTokenResource,
 		account.NewAccountResource,
 		medium.NewMediumResource,
 		mapnode.NewGroupResource,
+		portal.NewPortalResource,
+		document.NewDocumentResource,
+		link.NewLinkResource,
+		file.NewFileResource,
+		cypressattribute.NewCypressAttributeResource,
+		table.NewTableResource,
+		dynamictable.NewDynamicTableResource,
+		replicatedtable.NewReplicatedTableResource,
+		tablereplica.NewTableReplicaResource,
+		queue.NewQueueResource,
+		queueconsumerregistration.NewQueueConsumerRegistrationResource,
 		tabletcellbundle.NewTabletCellBundleResource,
+		clusternode.NewClusterNodeResource,
+		datacenter.NewDataCenterResource,
+		rack.NewRackResource,
+		hostrack.NewHostRackResource,
+		dynamicconfig.NewDynamicConfigResource,
 		schedulerpool.NewSchedulerPoolResource,
+		schedulerpooltree.NewSchedulerPoolTreeResource,
+		accesscontrolobjectnamespace.NewAccessControlObjectNamespaceResource,
+		accesscontrolobject.NewAccessControlObjectResource,
+		networkproject.NewNetworkProjectResource,
+		proxyrole.NewProxyRoleResource,
+		proxyroleassignment.NewProxyRoleAssignmentResource,
+		chytclique.NewChytCliqueResource,
 	}
 }
@@ -0,0 +1,256 @@
+package accesscontrolobject
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type accessControlObjectResource struct {
+	client yt.Client
+}
+
+type AccessControlObjectModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Namespace    types.String `tfsdk:"namespace"`
+	ACL          acl.ACLModel `tfsdk:"acl"`
+	PrincipalACL acl.ACLModel `tfsdk:"principal_acl"`
+}
+
+func toAccessControlObjectModel(o ytsaurus.AccessControlObject) AccessControlObjectModel {
+	return AccessControlObjectModel{
+		ID:           types.StringValue(o.ID),
+		Name:         types.StringValue(o.Name),
+		Namespace:    types.StringValue(o.Namespace),
+		ACL:          acl.ToACLModel(o.ACL),
+		PrincipalACL: acl.ToACLModel(o.PrincipalACL),
+	}
+}
+
+func toYTsaurusAccessControlObject(o AccessControlObjectModel) (ytsaurus.AccessControlObject, diag.Diagnostics) {
+	ytACL, diags := acl.ToYTsaurusACL(o.ACL)
+	principalACL, principalACLDiags := acl.ToYTsaurusACL(o.PrincipalACL)
+	diags.Append(principalACLDiags...)
+	return ytsaurus.AccessControlObject{
+		ID:           o.ID.ValueString(),
+		Name:         o.Name.ValueString(),
+		Namespace:    o.Namespace.ValueString(),
+		ACL:          ytACL,
+		PrincipalACL: principalACL,
+	}, diags
+}
+
+var (
+	_ resource.Resource                = &accessControlObjectResource{}
+	_ resource.ResourceWithConfigure   = &accessControlObjectResource{}
+	_ resource.ResourceWithImportState = &accessControlObjectResource{}
+)
+
+func NewAccessControlObjectResource() resource.Resource {
+	return &accessControlObjectResource{}
+}
+
+func (r *accessControlObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_control_object"
+}
+
+func (r *accessControlObjectResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *accessControlObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+An access control object (ACO) controls access to a non-Cypress entity, for example queries of the query tracker.
+The principal_acl is applied to the entity, the acl controls access to the ACO itself.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/storage/access-control`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Access control object name.",
+			},
+			"namespace": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Access control object namespace name, for example 'queries'.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records of the access control object itself. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+			"principal_acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records applied to the entities guarded by the access control object.",
+			},
+		},
+	}
+}
+
+func (r *accessControlObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccessControlObjectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytObject, diags := toYTsaurusAccessControlObject(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateObjectOptions{
+		Attributes: map[string]interface{}{
+			"name":               ytObject.Name,
+			"namespace":          ytObject.Namespace,
+			"acl":                ytObject.ACL,
+			"principal_acl":      ytObject.PrincipalACL,
+			"terraform_resource": true,
+		},
+	}
+
+	id, err := r.client.CreateObject(ctx, yt.NodeAccessControlObject, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating access_control_object",
+			fmt.Sprintf(
+				"Could not create access_control_object %q in namespace %q, unexpected error: %q",
+				ytObject.Name,
+				ytObject.Namespace,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *accessControlObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ytObject ytsaurus.AccessControlObject
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytObject); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading access_control_object",
+			fmt.Sprintf(
+				"Could not read access_control_object by id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := toAccessControlObjectModel(ytObject)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *accessControlObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AccessControlObjectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytObject, diags := toYTsaurusAccessControlObject(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID))
+	attributeUpdates := map[string]interface{}{
+		"acl":           ytObject.ACL,
+		"principal_acl": ytObject.PrincipalACL,
+	}
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating access_control_object attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(objectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *accessControlObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AccessControlObjectModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting access_control_object",
+			fmt.Sprintf(
+				"Could not delete access_control_object %q, unexpected error: %q",
+				state.Name.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *accessControlObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
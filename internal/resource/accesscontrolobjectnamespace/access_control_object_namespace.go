@@ -0,0 +1,227 @@
+package accesscontrolobjectnamespace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type accessControlObjectNamespaceResource struct {
+	client yt.Client
+}
+
+type AccessControlObjectNamespaceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	ACL  acl.ACLModel `tfsdk:"acl"`
+}
+
+func toAccessControlObjectNamespaceModel(n ytsaurus.AccessControlObjectNamespace) AccessControlObjectNamespaceModel {
+	return AccessControlObjectNamespaceModel{
+		ID:   types.StringValue(n.ID),
+		Name: types.StringValue(n.Name),
+		ACL:  acl.ToACLModel(n.ACL),
+	}
+}
+
+func toYTsaurusAccessControlObjectNamespace(n AccessControlObjectNamespaceModel) (ytsaurus.AccessControlObjectNamespace, diag.Diagnostics) {
+	acl, diags := acl.ToYTsaurusACL(n.ACL)
+	return ytsaurus.AccessControlObjectNamespace{
+		ID:   n.ID.ValueString(),
+		Name: n.Name.ValueString(),
+		ACL:  acl,
+	}, diags
+}
+
+var (
+	_ resource.Resource                = &accessControlObjectNamespaceResource{}
+	_ resource.ResourceWithConfigure   = &accessControlObjectNamespaceResource{}
+	_ resource.ResourceWithImportState = &accessControlObjectNamespaceResource{}
+)
+
+func NewAccessControlObjectNamespaceResource() resource.Resource {
+	return &accessControlObjectNamespaceResource{}
+}
+
+func (r *accessControlObjectNamespaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_control_object_namespace"
+}
+
+func (r *accessControlObjectNamespaceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *accessControlObjectNamespaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+An access control object namespace groups access control objects of one kind, for example 'queries'.
+Namespaces are stored under //sys/access_control_object_namespaces.
+
+The namespace has no principal_acl, only its own acl. principal_acl belongs to the access control objects,
+see ytsaurus_access_control_object.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/storage/access-control`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Access control object namespace name.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records of the namespace itself. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+		},
+	}
+}
+
+func (r *accessControlObjectNamespaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccessControlObjectNamespaceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytNamespace, diags := toYTsaurusAccessControlObjectNamespace(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateObjectOptions{
+		Attributes: map[string]interface{}{
+			"name":               ytNamespace.Name,
+			"acl":                ytNamespace.ACL,
+			"terraform_resource": true,
+		},
+	}
+
+	id, err := r.client.CreateObject(ctx, yt.NodeAccessControlObjectNamespace, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating access_control_object_namespace",
+			fmt.Sprintf(
+				"Could not create access_control_object_namespace %q, unexpected error: %q",
+				ytNamespace.Name,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *accessControlObjectNamespaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ytNamespace ytsaurus.AccessControlObjectNamespace
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytNamespace); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading access_control_object_namespace",
+			fmt.Sprintf(
+				"Could not read access_control_object_namespace by id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := toAccessControlObjectNamespaceModel(ytNamespace)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *accessControlObjectNamespaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AccessControlObjectNamespaceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytNamespace, diags := toYTsaurusAccessControlObjectNamespace(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID)).Attr("acl")
+	if err := r.client.SetNode(ctx, p, ytNamespace.ACL, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating access_control_object_namespace attributes",
+			fmt.Sprintf(
+				"Could not set node %q to '%v', unexpected error: %q",
+				p.String(),
+				ytNamespace.ACL,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(objectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *accessControlObjectNamespaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AccessControlObjectNamespaceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting access_control_object_namespace",
+			fmt.Sprintf(
+				"Could not delete access_control_object_namespace %q, unexpected error: %q",
+				state.Name.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *accessControlObjectNamespaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
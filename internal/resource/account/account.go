@@ -3,9 +3,13 @@ package account
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"go.ytsaurus.tech/yt/go/ypath"
@@ -35,39 +40,85 @@ type accountResource struct {
 	client yt.Client
 }
 
+type AccountMasterMemoryLimitsModel struct {
+	Total     types.Int64            `tfsdk:"total"`
+	ChunkHost types.Int64            `tfsdk:"chunk_host"`
+	PerCell   map[string]types.Int64 `tfsdk:"per_cell"`
+}
+
 type AccountResourceLimitsModel struct {
-	NodeCount          types.Int64            `tfsdk:"node_count"`
-	ChunkCount         types.Int64            `tfsdk:"chunk_count"`
-	TabletCount        types.Int64            `tfsdk:"tablet_count"`
-	TabletStaticMemory types.Int64            `tfsdk:"tablet_static_memory"`
-	DiskSpacePerMedium map[string]types.Int64 `tfsdk:"disk_space_per_medium"`
+	NodeCount                 types.Int64                     `tfsdk:"node_count"`
+	ChunkCount                types.Int64                     `tfsdk:"chunk_count"`
+	TabletCount               types.Int64                     `tfsdk:"tablet_count"`
+	TabletStaticMemory        types.Int64                     `tfsdk:"tablet_static_memory"`
+	DiskSpacePerMedium        map[string]types.Int64          `tfsdk:"disk_space_per_medium"`
+	MasterMemory              *AccountMasterMemoryLimitsModel `tfsdk:"master_memory"`
+	ChunkHostCellMasterMemory map[string]types.Int64          `tfsdk:"chunk_host_cell_master_memory"`
+}
+
+func toInt64MapModel(m map[string]int64) map[string]types.Int64 {
+	if len(m) == 0 {
+		return nil
+	}
+	result := make(map[string]types.Int64)
+	for k, v := range m {
+		result[k] = types.Int64Value(v)
+	}
+	return result
+}
+
+func toYTsaurusInt64Map(m map[string]types.Int64) map[string]int64 {
+	if len(m) == 0 {
+		return nil
+	}
+	result := make(map[string]int64)
+	for k, v := range m {
+		result[k] = v.ValueInt64()
+	}
+	return result
 }
 
 func toResourceLimitsModel(r ytsaurus.AccountResourceLimits) *AccountResourceLimitsModel {
 	resourceLimit := AccountResourceLimitsModel{
-		NodeCount:          types.Int64Value(r.NodeCount),
-		ChunkCount:         types.Int64Value(r.ChunkCount),
-		TabletCount:        types.Int64Value(r.TabletCount),
-		TabletStaticMemory: types.Int64Value(r.TabletStaticMemory),
-		DiskSpacePerMedium: make(map[string]types.Int64),
+		NodeCount:                 types.Int64Value(r.NodeCount),
+		ChunkCount:                types.Int64Value(r.ChunkCount),
+		TabletCount:               types.Int64Value(r.TabletCount),
+		TabletStaticMemory:        types.Int64Value(r.TabletStaticMemory),
+		DiskSpacePerMedium:        make(map[string]types.Int64),
+		ChunkHostCellMasterMemory: toInt64MapModel(r.ChunkHostCellMasterMemory),
 	}
 	for k, v := range r.DiskSpacePerMedium {
 		resourceLimit.DiskSpacePerMedium[k] = types.Int64Value(v)
 	}
+	if r.MasterMemory != nil {
+		resourceLimit.MasterMemory = &AccountMasterMemoryLimitsModel{
+			Total:     types.Int64Value(r.MasterMemory.Total),
+			ChunkHost: types.Int64PointerValue(r.MasterMemory.ChunkHost),
+			PerCell:   toInt64MapModel(r.MasterMemory.PerCell),
+		}
+	}
 	return &resourceLimit
 }
 
 func toYTsaurusAccountResourceLimits(r AccountResourceLimitsModel) ytsaurus.AccountResourceLimits {
 	resourceLimits := ytsaurus.AccountResourceLimits{
-		NodeCount:          r.NodeCount.ValueInt64(),
-		ChunkCount:         r.ChunkCount.ValueInt64(),
-		TabletCount:        r.TabletCount.ValueInt64(),
-		TabletStaticMemory: r.TabletStaticMemory.ValueInt64(),
-		DiskSpacePerMedium: make(map[string]int64),
+		NodeCount:                 r.NodeCount.ValueInt64(),
+		ChunkCount:                r.ChunkCount.ValueInt64(),
+		TabletCount:               r.TabletCount.ValueInt64(),
+		TabletStaticMemory:        r.TabletStaticMemory.ValueInt64(),
+		DiskSpacePerMedium:        make(map[string]int64),
+		ChunkHostCellMasterMemory: toYTsaurusInt64Map(r.ChunkHostCellMasterMemory),
 	}
 	for k, v := range r.DiskSpacePerMedium {
 		resourceLimits.DiskSpacePerMedium[k] = v.ValueInt64()
 	}
+	if r.MasterMemory != nil {
+		resourceLimits.MasterMemory = &ytsaurus.AccountMasterMemoryLimits{
+			Total:     r.MasterMemory.Total.ValueInt64(),
+			ChunkHost: r.MasterMemory.ChunkHost.ValueInt64Pointer(),
+			PerCell:   toYTsaurusInt64Map(r.MasterMemory.PerCell),
+		}
+	}
 	return resourceLimits
 }
 
@@ -205,6 +256,28 @@ https://ytsaurus.tech/docs/en/user-guide/storage/accounts
 						ElementType: types.Int64Type,
 						Description: "Disk space in bytes (for each medium)",
 					},
+					"master_memory": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Master memory in bytes",
+						Attributes: map[string]schema.Attribute{
+							"total": schema.Int64Attribute{
+								Required: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+								Description: "Master memory on all cells",
+							},
+							"chunk_host": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+								Description: "Master memory on all chunk host cells",
+							},
+							"per_cell": cellTagMapSchema("Master memory for each cell, keyed by master cell tag"),
+						},
+					},
+					"chunk_host_cell_master_memory": cellTagMapSchema("Master memory for each chunk host cell, keyed by master cell tag"),
 				},
 				PlanModifiers: []planmodifier.Object{
 					objectplanmodifier.UseStateForUnknown(),
@@ -214,6 +287,22 @@ https://ytsaurus.tech/docs/en/user-guide/storage/accounts
 	}
 }
 
+func cellTagMapSchema(description string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Optional:    true,
+		ElementType: types.Int64Type,
+		Validators: []validator.Map{
+			mapvalidator.SizeAtLeast(1),
+			mapvalidator.KeysAre(stringvalidator.RegexMatches(
+				regexp.MustCompile(`^[0-9]+$`),
+				"must be a master cell tag",
+			)),
+			mapvalidator.ValueInt64sAre(int64validator.AtLeast(0)),
+		},
+		Description: description,
+	}
+}
+
 func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan AccountModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -279,6 +368,24 @@ func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	state := toAccountModel(ytAccount)
+
+	// Reset master memory limits to nil if they weren't configured in .tf file
+	var currentState AccountModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentState)...)
+	if currentState.ResourceLimits == nil || currentState.ResourceLimits.MasterMemory == nil {
+		state.ResourceLimits.MasterMemory = nil
+	} else if state.ResourceLimits.MasterMemory != nil {
+		if currentState.ResourceLimits.MasterMemory.ChunkHost.IsNull() {
+			state.ResourceLimits.MasterMemory.ChunkHost = types.Int64Null()
+		}
+		if currentState.ResourceLimits.MasterMemory.PerCell == nil {
+			state.ResourceLimits.MasterMemory.PerCell = nil
+		}
+	}
+	if currentState.ResourceLimits == nil || currentState.ResourceLimits.ChunkHostCellMasterMemory == nil {
+		state.ResourceLimits.ChunkHostCellMasterMemory = nil
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -0,0 +1,493 @@
+package chytclique
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const (
+	chytFamily   = "chyt"
+	defaultStage = "production"
+)
+
+var managedSpecletKeys = []string{"family", "stage", "active", "pool", "instance_count"}
+
+type chytCliqueResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                     = &chytCliqueResource{}
+	_ resource.ResourceWithConfigure        = &chytCliqueResource{}
+	_ resource.ResourceWithImportState      = &chytCliqueResource{}
+	_ resource.ResourceWithConfigValidators = &chytCliqueResource{}
+)
+
+type ChytCliqueModel struct {
+	ID             types.String `tfsdk:"id"`
+	Alias          types.String `tfsdk:"alias"`
+	Pool           types.String `tfsdk:"pool"`
+	InstanceCount  types.Int64  `tfsdk:"instance_count"`
+	Active         types.Bool   `tfsdk:"active"`
+	Stage          types.String `tfsdk:"stage"`
+	SpecletOptions types.String `tfsdk:"speclet_options"`
+	ACL            acl.ACLModel `tfsdk:"acl"`
+}
+
+func cliquePath(alias string) ypath.Path {
+	return ypath.Path(fmt.Sprintf("//sys/strawberry/%s/%s", chytFamily, alias))
+}
+
+func cliqueACOPath(alias string) ypath.Path {
+	return ypath.Path(fmt.Sprintf("//sys/access_control_object_namespaces/%s/%s", chytFamily, alias))
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return v, true
+	case uint64:
+		return int64(v), true
+	case int:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func toChytCliqueModel(c ytsaurus.ChytClique) (ChytCliqueModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	clique := ChytCliqueModel{
+		ID:             types.StringValue(c.ID),
+		Alias:          types.StringValue(c.Alias),
+		Pool:           types.StringNull(),
+		InstanceCount:  types.Int64Null(),
+		Active:         types.BoolValue(false),
+		Stage:          types.StringValue(defaultStage),
+		SpecletOptions: types.StringNull(),
+		ACL:            acl.ToACLModel(c.PrincipalACL),
+	}
+
+	options := make(map[string]interface{})
+	for k, v := range c.Speclet {
+		options[k] = v
+	}
+	if pool, ok := options["pool"].(string); ok {
+		clique.Pool = types.StringValue(pool)
+	}
+	if instanceCount, ok := toInt64(options["instance_count"]); ok {
+		clique.InstanceCount = types.Int64Value(instanceCount)
+	}
+	if active, ok := options["active"].(bool); ok {
+		clique.Active = types.BoolValue(active)
+	}
+	if stage, ok := options["stage"].(string); ok {
+		clique.Stage = types.StringValue(stage)
+	}
+	for _, k := range managedSpecletKeys {
+		delete(options, k)
+	}
+
+	if len(options) > 0 {
+		specletOptions, err := ytsaurus.MarshalValue(options)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("speclet_options"),
+				"Invalid speclet_options",
+				fmt.Sprintf("Could not encode speclet options, unexpected error: %q", err.Error()),
+			)
+			return clique, diags
+		}
+		clique.SpecletOptions = types.StringValue(specletOptions)
+	}
+
+	return clique, diags
+}
+
+func toSpecletOptions(s types.String) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if s.IsNull() || s.IsUnknown() {
+		return nil, diags
+	}
+
+	value, err := ytsaurus.UnmarshalValue(s.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("speclet_options"),
+			"Invalid speclet_options",
+			err.Error(),
+		)
+		return nil, diags
+	}
+	options, ok := value.(map[string]interface{})
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("speclet_options"),
+			"Invalid speclet_options",
+			"speclet_options must be a map",
+		)
+		return nil, diags
+	}
+	for _, k := range managedSpecletKeys {
+		if _, ok := options[k]; ok {
+			diags.AddAttributeError(
+				path.Root("speclet_options"),
+				"Invalid speclet_options",
+				fmt.Sprintf("Speclet option %q is managed by a dedicated attribute", k),
+			)
+			return nil, diags
+		}
+	}
+
+	return options, diags
+}
+
+func toYTsaurusChytClique(c ChytCliqueModel) (ytsaurus.ChytClique, diag.Diagnostics) {
+	principalACL, diags := acl.ToYTsaurusACL(c.ACL)
+	clique := ytsaurus.ChytClique{
+		ID:           c.ID.ValueString(),
+		Alias:        c.Alias.ValueString(),
+		Speclet:      make(map[string]interface{}),
+		PrincipalACL: principalACL,
+	}
+
+	options, optionsDiags := toSpecletOptions(c.SpecletOptions)
+	diags.Append(optionsDiags...)
+	if diags.HasError() {
+		return clique, diags
+	}
+	if options != nil {
+		clique.Speclet = options
+	}
+
+	clique.Speclet["family"] = chytFamily
+	clique.Speclet["stage"] = c.Stage.ValueString()
+	clique.Speclet["active"] = c.Active.ValueBool()
+	if !c.Pool.IsNull() {
+		clique.Speclet["pool"] = c.Pool.ValueString()
+	}
+	if !c.InstanceCount.IsNull() {
+		clique.Speclet["instance_count"] = c.InstanceCount.ValueInt64()
+	}
+
+	return clique, diags
+}
+
+func NewChytCliqueResource() resource.Resource {
+	return &chytCliqueResource{}
+}
+
+func (r *chytCliqueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chyt_clique"
+}
+
+func (r *chytCliqueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A CHYT clique managed by the strawberry controller. The resource shapes the Cypress state the controller consumes:
+the clique node //sys/strawberry/chyt/<alias> with its speclet document and the clique's access control object
+in the 'chyt' namespace. The controller itself is not required to create the resource.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/data-processing/chyt/cliques/start`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "ObjectID of the clique node in the YTsaurus cluster.",
+			},
+			"alias": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Clique alias.",
+			},
+			"pool": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Scheduler pool the clique operation is started in, for example ytsaurus_scheduler_pool.<name>.name.",
+			},
+			"instance_count": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Number of clique instances.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the controller keeps the clique running.",
+			},
+			"stage": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultStage),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Strawberry controller stage serving the clique.",
+			},
+			"speclet_options": schema.StringAttribute{
+				Optional:    true,
+				Description: "A JSON or YSON encoded map of other speclet options, for example jsonencode({instance_cpu = 8}).",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records of the clique's access control object, grants 'use' of the clique. The ACL is not managed when unset.",
+			},
+		},
+	}
+}
+
+func (r *chytCliqueResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *chytCliqueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ChytCliqueModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytClique, diags := toYTsaurusChytClique(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := cliquePath(ytClique.Alias)
+	createOptions := &yt.CreateNodeOptions{
+		Recursive: true,
+		Attributes: map[string]interface{}{
+			"terraform_resource": true,
+		},
+	}
+	id, err := r.client.CreateNode(ctx, p, yt.NodeMap, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating chyt_clique",
+			fmt.Sprintf(
+				"Could not create clique node %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if _, err := r.client.CreateNode(ctx, p.Child("speclet"), yt.NodeDocument, nil); err == nil {
+		err = r.client.SetNode(ctx, p.Child("speclet"), ytClique.Speclet, nil)
+	}
+	if err != nil {
+		_ = ytsaurus.RemoveIfExists(ctx, r.client, p)
+		resp.Diagnostics.AddError(
+			"Error creating chyt_clique",
+			fmt.Sprintf(
+				"Could not write clique speclet %q, unexpected error: %q",
+				p.Child("speclet").String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	acoOptions := &yt.CreateObjectOptions{
+		Attributes: map[string]interface{}{
+			"name":               ytClique.Alias,
+			"namespace":          chytFamily,
+			"principal_acl":      ytClique.PrincipalACL,
+			"terraform_resource": true,
+		},
+	}
+	if _, err := r.client.CreateObject(ctx, yt.NodeAccessControlObject, acoOptions); err != nil {
+		_ = ytsaurus.RemoveIfExists(ctx, r.client, p)
+		resp.Diagnostics.AddError(
+			"Error creating chyt_clique",
+			fmt.Sprintf(
+				"Could not create access control object for clique %q, unexpected error: %q",
+				ytClique.Alias,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *chytCliqueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ChytCliqueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytClique, err := r.read(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading chyt_clique",
+			fmt.Sprintf(
+				"Could not read chyt_clique with id %q, unexpected error: %q",
+				state.ID.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	newState, diags := toChytCliqueModel(ytClique)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.SpecletOptions.IsNull() && !newState.SpecletOptions.IsNull() &&
+		ytsaurus.IsEqualValues(state.SpecletOptions.ValueString(), newState.SpecletOptions.ValueString()) {
+		newState.SpecletOptions = state.SpecletOptions
+	}
+	// ACEs managed outside of Terraform are not reported when acl is unset,
+	// an imported clique (no alias in state yet) keeps the ACL it has.
+	if state.ACL == nil && !state.Alias.IsNull() {
+		newState.ACL = nil
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *chytCliqueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ChytCliqueModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state ChytCliqueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytClique, diags := toYTsaurusChytClique(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributeUpdates := map[ypath.Path]interface{}{
+		cliquePath(ytClique.Alias).Child("speclet"): ytClique.Speclet,
+	}
+	if plan.ACL != nil {
+		attributeUpdates[cliqueACOPath(ytClique.Alias).Attr("principal_acl")] = ytClique.PrincipalACL
+	}
+	for p, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p, v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating chyt_clique",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *chytCliqueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ChytCliqueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range []ypath.Path{cliqueACOPath(state.Alias.ValueString()), cliquePath(state.Alias.ValueString())} {
+		if err := ytsaurus.RemoveIfExists(ctx, r.client, p); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting chyt_clique",
+				fmt.Sprintf(
+					"Could not delete %q, unexpected error: %q",
+					p.String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+}
+
+func (r *chytCliqueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *chytCliqueResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		chytCliqueResourceConfigValidator{},
+	}
+}
+
+func (r *chytCliqueResource) read(ctx context.Context, objectID string) (ytsaurus.ChytClique, error) {
+	var ytClique ytsaurus.ChytClique
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytClique); err != nil {
+		return ytClique, err
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID))
+	if err := r.client.GetNode(ctx, p.Attr("key"), &ytClique.Alias, nil); err != nil {
+		return ytClique, err
+	}
+
+	if err := r.client.GetNode(ctx, p.Child("speclet"), &ytClique.Speclet, nil); err != nil {
+		return ytClique, err
+	}
+
+	acoPath := cliqueACOPath(ytClique.Alias).Attr("principal_acl")
+	if err := r.client.GetNode(ctx, acoPath, &ytClique.PrincipalACL, nil); err != nil {
+		return ytClique, err
+	}
+
+	return ytClique, nil
+}
@@ -0,0 +1,32 @@
+package chytclique
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type chytCliqueResourceConfigValidator struct{}
+
+var _ resource.ConfigValidator = &chytCliqueResourceConfigValidator{}
+
+func (v chytCliqueResourceConfigValidator) Description(_ context.Context) string {
+	return ""
+}
+
+func (v chytCliqueResourceConfigValidator) MarkdownDescription(_ context.Context) string {
+	return ""
+}
+
+func (v chytCliqueResourceConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var specletOptions types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("speclet_options"), &specletOptions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := toSpecletOptions(specletOptions)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,514 @@
+package clusternode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+var managedAttributes = []string{
+	"user_tags",
+	"banned",
+	"decommissioned",
+	"disable_scheduler_jobs",
+	"disable_write_sessions",
+}
+
+type clusterNodeResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                = &clusterNodeResource{}
+	_ resource.ResourceWithConfigure   = &clusterNodeResource{}
+	_ resource.ResourceWithImportState = &clusterNodeResource{}
+)
+
+type ClusterNodeModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Address              types.String `tfsdk:"address"`
+	UserTags             types.Set    `tfsdk:"user_tags"`
+	Banned               types.Bool   `tfsdk:"banned"`
+	Decommissioned       types.Bool   `tfsdk:"decommissioned"`
+	DisableSchedulerJobs types.Bool   `tfsdk:"disable_scheduler_jobs"`
+	DisableWriteSessions types.Bool   `tfsdk:"disable_write_sessions"`
+	PreviousAttributes   types.Object `tfsdk:"previous_attributes"`
+}
+
+var previousAttributesAttrTypes = map[string]attr.Type{
+	"user_tags":              types.SetType{ElemType: types.StringType},
+	"banned":                 types.BoolType,
+	"decommissioned":         types.BoolType,
+	"disable_scheduler_jobs": types.BoolType,
+	"disable_write_sessions": types.BoolType,
+}
+
+func clusterNodePath(address string) ypath.Path {
+	return ypath.Path("//sys/cluster_nodes").Child(address)
+}
+
+func toUserTagsSet(userTags []string) types.Set {
+	values := []attr.Value{}
+	for _, t := range userTags {
+		values = append(values, types.StringValue(t))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+func toClusterNodeModel(n ytsaurus.ClusterNode) ClusterNodeModel {
+	node := ClusterNodeModel{
+		ID:                   types.StringValue(n.Address),
+		Address:              types.StringValue(n.Address),
+		UserTags:             types.SetNull(types.StringType),
+		Banned:               types.BoolValue(n.Banned),
+		Decommissioned:       types.BoolValue(n.Decommissioned),
+		DisableSchedulerJobs: types.BoolValue(n.DisableSchedulerJobs),
+		DisableWriteSessions: types.BoolValue(n.DisableWriteSessions),
+	}
+
+	if len(n.UserTags) > 0 {
+		node.UserTags = toUserTagsSet(n.UserTags)
+	}
+
+	return node
+}
+
+// managedValues returns the model attributes keyed by their YTsaurus names, null values are not managed.
+func managedValues(n ClusterNodeModel) map[string]attr.Value {
+	return map[string]attr.Value{
+		"user_tags":              n.UserTags,
+		"banned":                 n.Banned,
+		"decommissioned":         n.Decommissioned,
+		"disable_scheduler_jobs": n.DisableSchedulerJobs,
+		"disable_write_sessions": n.DisableWriteSessions,
+	}
+}
+
+// previousValue returns the value to restore the attribute to, empty user_tags are kept as an empty set.
+func previousValue(n ytsaurus.ClusterNode, name string) attr.Value {
+	switch name {
+	case "user_tags":
+		return toUserTagsSet(n.UserTags)
+	case "banned":
+		return types.BoolValue(n.Banned)
+	case "decommissioned":
+		return types.BoolValue(n.Decommissioned)
+	case "disable_scheduler_jobs":
+		return types.BoolValue(n.DisableSchedulerJobs)
+	default:
+		return types.BoolValue(n.DisableWriteSessions)
+	}
+}
+
+func nullValue(name string) attr.Value {
+	if name == "user_tags" {
+		return types.SetNull(types.StringType)
+	}
+	return types.BoolNull()
+}
+
+func toYTsaurusValue(ctx context.Context, v attr.Value) (interface{}, diag.Diagnostics) {
+	switch v := v.(type) {
+	case types.Set:
+		userTags := []string{}
+		diags := v.ElementsAs(ctx, &userTags, false)
+		return userTags, diags
+	case types.Bool:
+		return v.ValueBool(), nil
+	default:
+		return nil, nil
+	}
+}
+
+func previousAttributes(o types.Object) map[string]attr.Value {
+	previous := make(map[string]attr.Value)
+	for _, name := range managedAttributes {
+		previous[name] = nullValue(name)
+	}
+	if !o.IsNull() && !o.IsUnknown() {
+		for k, v := range o.Attributes() {
+			previous[k] = v
+		}
+	}
+	return previous
+}
+
+func NewClusterNodeResource() resource.Resource {
+	return &clusterNodeResource{}
+}
+
+func (r *clusterNodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_node"
+}
+
+func (r *clusterNodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Configuration of an existing cluster node registered under //sys/cluster_nodes. The node is never created or removed
+by the resource. Only the attributes set in the configuration are managed, destroying the resource or removing
+an attribute from the configuration restores the value the attribute had before.
+
+More information:
+https://ytsaurus.tech/docs/en/admin-guide/node-maintenance`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Node address, the same as address.",
+			},
+			"address": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Node address in the form <host>:<port>.",
+			},
+			"user_tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				Description: "A set of node tags, can be used in node_tag_filter of tablet cell bundles and pool trees.",
+			},
+			"banned": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Banned nodes are disconnected from the cluster.",
+			},
+			"decommissioned": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Chunks are moved away from decommissioned nodes.",
+			},
+			"disable_scheduler_jobs": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Disable scheduling of new jobs on the node.",
+			},
+			"disable_write_sessions": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Disable new chunk write sessions on the node.",
+			},
+			"previous_attributes": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"user_tags": schema.SetAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"banned": schema.BoolAttribute{
+						Computed: true,
+					},
+					"decommissioned": schema.BoolAttribute{
+						Computed: true,
+					},
+					"disable_scheduler_jobs": schema.BoolAttribute{
+						Computed: true,
+					},
+					"disable_write_sessions": schema.BoolAttribute{
+						Computed: true,
+					},
+				},
+				Description: "Values the managed attributes had before the resource took them over, restored on destroy. Null for imported attributes.",
+			},
+		},
+	}
+}
+
+func (r *clusterNodeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *clusterNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ClusterNodeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	address := plan.Address.ValueString()
+	p := clusterNodePath(address)
+	ok, err := r.client.NodeExists(ctx, p, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating cluster_node",
+			fmt.Sprintf(
+				"Could not check cluster node %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error creating cluster_node",
+			fmt.Sprintf("Cluster node %q is not registered", address),
+		)
+		return
+	}
+
+	current, err := r.readNode(ctx, address)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating cluster_node",
+			fmt.Sprintf(
+				"Could not read cluster node %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	previous := previousAttributes(types.ObjectNull(previousAttributesAttrTypes))
+	attributeUpdates := make(map[string]attr.Value)
+	for name, v := range managedValues(plan) {
+		if v.IsNull() {
+			continue
+		}
+		previous[name] = previousValue(current, name)
+		attributeUpdates[name] = v
+	}
+
+	resp.Diagnostics.Append(r.setAttributes(ctx, address, attributeUpdates)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(address)
+	plan.PreviousAttributes = types.ObjectValueMust(previousAttributesAttrTypes, previous)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *clusterNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ClusterNodeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	address := state.ID.ValueString()
+	p := clusterNodePath(address)
+	ok, err := r.client.NodeExists(ctx, p, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading cluster_node",
+			fmt.Sprintf(
+				"Could not check cluster node %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ytNode, err := r.readNode(ctx, address)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading cluster_node",
+			fmt.Sprintf(
+				"Could not read cluster node %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	// Attributes absent in the state are not managed by the resource.
+	newState := toClusterNodeModel(ytNode)
+	if state.UserTags.IsNull() {
+		newState.UserTags = state.UserTags
+	}
+	if state.Banned.IsNull() {
+		newState.Banned = state.Banned
+	}
+	if state.Decommissioned.IsNull() {
+		newState.Decommissioned = state.Decommissioned
+	}
+	if state.DisableSchedulerJobs.IsNull() {
+		newState.DisableSchedulerJobs = state.DisableSchedulerJobs
+	}
+	if state.DisableWriteSessions.IsNull() {
+		newState.DisableWriteSessions = state.DisableWriteSessions
+	}
+	newState.PreviousAttributes = state.PreviousAttributes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *clusterNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ClusterNodeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state ClusterNodeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	address := state.ID.ValueString()
+	current, err := r.readNode(ctx, address)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating cluster_node",
+			fmt.Sprintf(
+				"Could not read cluster node %q, unexpected error: %q",
+				clusterNodePath(address).String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	previous := previousAttributes(state.PreviousAttributes)
+	stateValues := managedValues(state)
+	attributeUpdates := make(map[string]attr.Value)
+	for name, v := range managedValues(plan) {
+		switch {
+		case !v.IsNull():
+			if stateValues[name].IsNull() {
+				previous[name] = previousValue(current, name)
+			}
+			attributeUpdates[name] = v
+		case !stateValues[name].IsNull():
+			// The attribute is no longer managed, give it back its previous value.
+			if !previous[name].IsNull() {
+				attributeUpdates[name] = previous[name]
+			}
+			previous[name] = nullValue(name)
+		}
+	}
+
+	resp.Diagnostics.Append(r.setAttributes(ctx, address, attributeUpdates)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.PreviousAttributes = types.ObjectValueMust(previousAttributesAttrTypes, previous)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *clusterNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ClusterNodeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	address := state.ID.ValueString()
+	p := clusterNodePath(address)
+	ok, err := r.client.NodeExists(ctx, p, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting cluster_node",
+			fmt.Sprintf(
+				"Could not check cluster node %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	previous := previousAttributes(state.PreviousAttributes)
+	attributeUpdates := make(map[string]attr.Value)
+	for name, v := range managedValues(state) {
+		if !v.IsNull() && !previous[name].IsNull() {
+			attributeUpdates[name] = previous[name]
+		}
+	}
+
+	resp.Diagnostics.Append(r.setAttributes(ctx, address, attributeUpdates)...)
+}
+
+// ImportState adopts the attributes which differ from the cluster defaults. Nothing is recorded to be restored,
+// so destroying an imported resource leaves the node as it is.
+func (r *clusterNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ytNode, err := r.readNode(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing cluster_node",
+			fmt.Sprintf(
+				"Could not read cluster node %q, unexpected error: %q",
+				clusterNodePath(req.ID).String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := toClusterNodeModel(ytNode)
+	for _, v := range []*types.Bool{&state.Banned, &state.Decommissioned, &state.DisableSchedulerJobs, &state.DisableWriteSessions} {
+		if !v.ValueBool() {
+			*v = types.BoolNull()
+		}
+	}
+	state.PreviousAttributes = types.ObjectValueMust(previousAttributesAttrTypes, previousAttributes(types.ObjectNull(previousAttributesAttrTypes)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *clusterNodeResource) readNode(ctx context.Context, address string) (ytsaurus.ClusterNode, error) {
+	ytNode := ytsaurus.ClusterNode{Address: address}
+	getOptions := &yt.GetNodeOptions{
+		Attributes: managedAttributes,
+	}
+	err := r.client.GetNode(ctx, clusterNodePath(address).Attrs(), &ytNode, getOptions)
+	return ytNode, err
+}
+
+func (r *clusterNodeResource) setAttributes(ctx context.Context, address string, attributeUpdates map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	p := clusterNodePath(address)
+	for k, v := range attributeUpdates {
+		value, d := toYTsaurusValue(ctx, v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if err := r.client.SetNode(ctx, p.Attr(k), value, nil); err != nil {
+			diags.AddError(
+				"Error setting cluster_node attribute",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					value,
+					err.Error(),
+				),
+			)
+			return diags
+		}
+	}
+	return diags
+}
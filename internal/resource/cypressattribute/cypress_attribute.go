@@ -0,0 +1,309 @@
+package cypressattribute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const idSeparator = "/@"
+
+type cypressAttributeResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                     = &cypressAttributeResource{}
+	_ resource.ResourceWithConfigure        = &cypressAttributeResource{}
+	_ resource.ResourceWithImportState      = &cypressAttributeResource{}
+	_ resource.ResourceWithConfigValidators = &cypressAttributeResource{}
+)
+
+type CypressAttributeModel struct {
+	ID    types.String `tfsdk:"id"`
+	Path  types.String `tfsdk:"path"`
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+func toCypressAttributeModel(a ytsaurus.CypressAttribute) (CypressAttributeModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attribute := CypressAttributeModel{
+		ID:   types.StringValue(a.Path + idSeparator + a.Name),
+		Path: types.StringValue(a.Path),
+		Name: types.StringValue(a.Name),
+	}
+
+	value, err := ytsaurus.MarshalValue(a.Value)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("value"),
+			"Invalid attribute value",
+			fmt.Sprintf("Could not encode attribute value, unexpected error: %q", err.Error()),
+		)
+		return attribute, diags
+	}
+	attribute.Value = types.StringValue(value)
+
+	return attribute, diags
+}
+
+func toYTsaurusCypressAttribute(a CypressAttributeModel) (ytsaurus.CypressAttribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attribute := ytsaurus.CypressAttribute{
+		Path: a.Path.ValueString(),
+		Name: a.Name.ValueString(),
+	}
+
+	if !a.Value.IsNull() && !a.Value.IsUnknown() {
+		value, err := ytsaurus.UnmarshalValue(a.Value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("value"),
+				"Invalid attribute value",
+				err.Error(),
+			)
+			return attribute, diags
+		}
+		attribute.Value = value
+	}
+
+	return attribute, diags
+}
+
+func NewCypressAttributeResource() resource.Resource {
+	return &cypressAttributeResource{}
+}
+
+func (r *cypressAttributeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cypress_attribute"
+}
+
+func (r *cypressAttributeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A single user or builtin attribute of an existing Cypress node or object, for example @expiration_time or @tablet_balancer_config.
+The node itself is not managed by the resource, destroying the resource removes only the attribute.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/storage/attributes`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Attribute path in the form <path>/@<name>.",
+			},
+			"path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Node absolute path or an object id path like #<id>.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Attribute name, may be a path inside a composite attribute like tablet_balancer_config/enable_auto_reshard.",
+			},
+			"value": schema.StringAttribute{
+				Required:    true,
+				Description: "A JSON or YSON encoded attribute value, for example jsonencode(\"2030-01-01T00:00:00Z\"). The value is compared semantically, so key order and formatting do not cause a diff.",
+			},
+		},
+	}
+}
+
+func (r *cypressAttributeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *cypressAttributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CypressAttributeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytAttribute, diags := toYTsaurusCypressAttribute(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(ytAttribute.Path).Attr(ytAttribute.Name)
+	if err := r.client.SetNode(ctx, p, ytAttribute.Value, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating cypress_attribute",
+			fmt.Sprintf(
+				"Could not set attribute %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(ytAttribute.Path + idSeparator + ytAttribute.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *cypressAttributeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CypressAttributeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytAttribute := ytsaurus.CypressAttribute{
+		Path: state.Path.ValueString(),
+		Name: state.Name.ValueString(),
+	}
+	p := ypath.Path(ytAttribute.Path).Attr(ytAttribute.Name)
+
+	ok, err := r.client.NodeExists(ctx, p, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading cypress_attribute",
+			fmt.Sprintf(
+				"Could not check attribute %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := r.client.GetNode(ctx, p, &ytAttribute.Value, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading cypress_attribute",
+			fmt.Sprintf(
+				"Could not read attribute %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	newState, diags := toCypressAttributeModel(ytAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Value.IsNull() && ytsaurus.IsEqualValues(state.Value.ValueString(), newState.Value.ValueString()) {
+		newState.Value = state.Value
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *cypressAttributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CypressAttributeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state CypressAttributeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytAttribute, diags := toYTsaurusCypressAttribute(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(ytAttribute.Path).Attr(ytAttribute.Name)
+	if !ytsaurus.IsEqualValues(plan.Value.ValueString(), state.Value.ValueString()) {
+		if err := r.client.SetNode(ctx, p, ytAttribute.Value, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cypress_attribute",
+				fmt.Sprintf(
+					"Could not set attribute %q, unexpected error: %q",
+					p.String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *cypressAttributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CypressAttributeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(state.Path.ValueString()).Attr(state.Name.ValueString())
+	if err := ytsaurus.RemoveIfExists(ctx, r.client, p); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting cypress_attribute",
+			fmt.Sprintf(
+				"Could not remove attribute %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *cypressAttributeResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		cypressAttributeResourceConfigValidator{},
+	}
+}
+
+func (r *cypressAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	i := strings.LastIndex(req.ID, idSeparator)
+	if i <= 0 || i+len(idSeparator) == len(req.ID) {
+		resp.Diagnostics.AddError(
+			"Error importing cypress_attribute",
+			fmt.Sprintf("Expected import id in the form <path>/@<name>, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID[i+len(idSeparator):])...)
+}
@@ -0,0 +1,30 @@
+package cypressattribute
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+type cypressAttributeResourceConfigValidator struct{}
+
+var _ resource.ConfigValidator = &cypressAttributeResourceConfigValidator{}
+
+func (v cypressAttributeResourceConfigValidator) Description(_ context.Context) string {
+	return ""
+}
+
+func (v cypressAttributeResourceConfigValidator) MarkdownDescription(_ context.Context) string {
+	return ""
+}
+
+func (v cypressAttributeResourceConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CypressAttributeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := toYTsaurusCypressAttribute(config)
+	resp.Diagnostics.Append(diags...)
+}
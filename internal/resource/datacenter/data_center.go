@@ -0,0 +1,188 @@
+package datacenter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const nodeTypeDataCenter yt.NodeType = "data_center"
+
+type dataCenterResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                = &dataCenterResource{}
+	_ resource.ResourceWithConfigure   = &dataCenterResource{}
+	_ resource.ResourceWithImportState = &dataCenterResource{}
+)
+
+type DataCenterModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func toDataCenterModel(d ytsaurus.DataCenter) DataCenterModel {
+	return DataCenterModel{
+		ID:   types.StringValue(d.ID),
+		Name: types.StringValue(d.Name),
+	}
+}
+
+func NewDataCenterResource() resource.Resource {
+	return &dataCenterResource{}
+}
+
+func (r *dataCenterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_center"
+}
+
+func (r *dataCenterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A data center groups racks, chunk replicas are spread across data centers the same way they are spread across racks.
+
+More information:
+https://ytsaurus.tech/docs/en/admin-guide/cluster-topology`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "YTsaurus data center name.",
+			},
+		},
+	}
+}
+
+func (r *dataCenterResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *dataCenterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DataCenterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateObjectOptions{
+		Attributes: map[string]interface{}{
+			"name":               plan.Name.ValueString(),
+			"terraform_resource": true,
+		},
+	}
+	id, err := r.client.CreateObject(ctx, nodeTypeDataCenter, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating data_center",
+			fmt.Sprintf(
+				"Could not create data_center %q, unexpected error: %q",
+				plan.Name.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dataCenterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var dataCenter ytsaurus.DataCenter
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &dataCenter); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading data_center",
+			fmt.Sprintf(
+				"Could not read data_center by id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := toDataCenterModel(dataCenter)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dataCenterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DataCenterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state DataCenterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString())).Attr("name")
+	if err := r.client.SetNode(ctx, p, plan.Name.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating data_center 'name' attribute",
+			fmt.Sprintf(
+				"Could not set node %q to %q, unexpected error: %q",
+				p.String(),
+				plan.Name.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dataCenterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID))
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting data_center",
+			fmt.Sprintf(
+				"Could not delete data_center %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *dataCenterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
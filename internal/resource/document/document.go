@@ -0,0 +1,388 @@
+package document
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type documentResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                     = &documentResource{}
+	_ resource.ResourceWithConfigure        = &documentResource{}
+	_ resource.ResourceWithImportState      = &documentResource{}
+	_ resource.ResourceWithConfigValidators = &documentResource{}
+)
+
+type DocumentModel struct {
+	ID         types.String `tfsdk:"id"`
+	Path       types.String `tfsdk:"path"`
+	Value      types.String `tfsdk:"value"`
+	Account    types.String `tfsdk:"account"`
+	InheritACL types.Bool   `tfsdk:"inherit_acl"`
+	ACL        acl.ACLModel `tfsdk:"acl"`
+}
+
+func toDocumentModel(d ytsaurus.Document) (DocumentModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	document := DocumentModel{
+		ID:         types.StringValue(d.ID),
+		Path:       types.StringValue(d.Path),
+		Account:    types.StringValue(d.Account),
+		InheritACL: types.BoolValue(d.InheritACL),
+		ACL:        acl.ToACLModel(d.ACL),
+	}
+
+	value, err := ytsaurus.MarshalValue(d.Value)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("value"),
+			"Invalid document value",
+			fmt.Sprintf("Could not encode document value, unexpected error: %q", err.Error()),
+		)
+		return document, diags
+	}
+	document.Value = types.StringValue(value)
+
+	return document, diags
+}
+
+func toYTsaurusDocument(d DocumentModel) (ytsaurus.Document, diag.Diagnostics) {
+	acl, diags := acl.ToYTsaurusACL(d.ACL)
+	document := ytsaurus.Document{
+		Path:       d.Path.ValueString(),
+		Account:    d.Account.ValueString(),
+		InheritACL: d.InheritACL.ValueBool(),
+		ACL:        acl,
+	}
+
+	if !d.Value.IsNull() && !d.Value.IsUnknown() {
+		value, err := ytsaurus.UnmarshalValue(d.Value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("value"),
+				"Invalid document value",
+				err.Error(),
+			)
+			return document, diags
+		}
+		document.Value = value
+	}
+
+	return document, diags
+}
+
+func NewDocumentResource() resource.Resource {
+	return &documentResource{}
+}
+
+func (r *documentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_document"
+}
+
+func (r *documentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A document is a Cypress node which stores an arbitrary YSON value, for example a service config.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/storage/objects#documents`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in object's @id attribute.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Node absolute path.",
+			},
+			"value": schema.StringAttribute{
+				Required:    true,
+				Description: "A JSON or YSON encoded document value. HCL values are passed with jsonencode, for example jsonencode({ key = \"value\" }). The value is compared semantically, changes of key order or formatting only update the state and don't rewrite the document.",
+			},
+			"account": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Account used to keep track of the resources being used by a specific node.",
+			},
+			"inherit_acl": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Enable or disable ACL inheritance from an object's parents.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+		},
+	}
+}
+
+func (r *documentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *documentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DocumentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytDocument, diags := toYTsaurusDocument(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateNodeOptions{
+		Attributes: map[string]interface{}{
+			"acl":                ytDocument.ACL,
+			"inherit_acl":        ytDocument.InheritACL,
+			"terraform_resource": true,
+		},
+	}
+	if ytDocument.Account != "" {
+		createOptions.Attributes["account"] = ytDocument.Account
+	}
+
+	p := ypath.Path(ytDocument.Path)
+	id, err := r.client.CreateNode(ctx, p, yt.NodeDocument, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating document",
+			fmt.Sprintf(
+				"Could not create document %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := r.client.SetNode(ctx, p, ytDocument.Value, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating document",
+			fmt.Sprintf(
+				"Could not set document %q value, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		if err := ytsaurus.RemoveIfExists(ctx, r.client, p); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating document",
+				fmt.Sprintf(
+					"Could not remove document %q, unexpected error: %q",
+					p.String(),
+					err.Error(),
+				),
+			)
+		}
+		return
+	}
+
+	if ytDocument.Account == "" {
+		if err := r.client.GetNode(ctx, p.Attr("account"), &ytDocument.Account, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating document",
+				fmt.Sprintf(
+					"Could not read 'account' attribute, unexpected error: %q",
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(id.String())
+	plan.Account = types.StringValue(ytDocument.Account)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *documentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DocumentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectID := state.ID.ValueString()
+	var document ytsaurus.Document
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &document); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading document",
+			fmt.Sprintf(
+				"Could not read document with id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID))
+	if err := r.client.GetNode(ctx, p.Attr("path"), &document.Path, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading document @path attribute",
+			fmt.Sprintf(
+				"Could not read document %q, unexpected error: %q",
+				p.Attr("path").String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := r.client.GetNode(ctx, p, &document.Value, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading document value",
+			fmt.Sprintf(
+				"Could not read document %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	newState, diags := toDocumentModel(document)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Value.IsNull() && ytsaurus.IsEqualValues(state.Value.ValueString(), newState.Value.ValueString()) {
+		newState.Value = state.Value
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *documentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DocumentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state DocumentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Path.Equal(state.Path) {
+		resp.Diagnostics.AddError(
+			"Error updating document attributes",
+			"Builtin attribute 'path' cannot be updated",
+		)
+		return
+	}
+
+	ytDocument, diags := toYTsaurusDocument(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	if !ytsaurus.IsEqualValues(plan.Value.ValueString(), state.Value.ValueString()) {
+		if err := r.client.SetNode(ctx, p, ytDocument.Value, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating document value",
+				fmt.Sprintf(
+					"Could not set document %q value, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	attributeUpdates := map[string]interface{}{
+		"account":     ytDocument.Account,
+		"acl":         ytDocument.ACL,
+		"inherit_acl": ytDocument.InheritACL,
+	}
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating document attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *documentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DocumentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(state.Path.ValueString())
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting document",
+			fmt.Sprintf(
+				"Could not delete document %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *documentResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		documentResourceConfigValidator{},
+	}
+}
+
+func (r *documentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,30 @@
+package document
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+type documentResourceConfigValidator struct{}
+
+var _ resource.ConfigValidator = &documentResourceConfigValidator{}
+
+func (v documentResourceConfigValidator) Description(_ context.Context) string {
+	return ""
+}
+
+func (v documentResourceConfigValidator) MarkdownDescription(_ context.Context) string {
+	return ""
+}
+
+func (v documentResourceConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DocumentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := toYTsaurusDocument(config)
+	resp.Diagnostics.Append(diags...)
+}
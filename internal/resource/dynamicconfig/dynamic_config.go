@@ -0,0 +1,357 @@
+package dynamicconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type dynamicConfigTarget struct {
+	path ypath.Path
+	// Config documents are created on demand, attributes are set directly.
+	isDocument bool
+}
+
+var dynamicConfigTargets = map[string]dynamicConfigTarget{
+	"node":             {path: ypath.Path("//sys/cluster_nodes/@config")},
+	"scheduler":        {path: ypath.Path("//sys/scheduler/config"), isDocument: true},
+	"controller_agent": {path: ypath.Path("//sys/controller_agents/config"), isDocument: true},
+	"master":           {path: ypath.Path("//sys/@config")},
+	"http_proxy":       {path: ypath.Path("//sys/http_proxies/@config")},
+	"rpc_proxy":        {path: ypath.Path("//sys/rpc_proxies/@config")},
+}
+
+type dynamicConfigResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                     = &dynamicConfigResource{}
+	_ resource.ResourceWithConfigure        = &dynamicConfigResource{}
+	_ resource.ResourceWithConfigValidators = &dynamicConfigResource{}
+)
+
+type DynamicConfigModel struct {
+	ID     types.String `tfsdk:"id"`
+	Target types.String `tfsdk:"target"`
+	Patch  types.String `tfsdk:"patch"`
+}
+
+func toPatch(m DynamicConfigModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if m.Patch.IsNull() || m.Patch.IsUnknown() {
+		return nil, diags
+	}
+
+	value, err := ytsaurus.UnmarshalValue(m.Patch.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("patch"),
+			"Invalid dynamic config patch",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	patch, ok := value.(map[string]interface{})
+	if !ok || len(patch) == 0 {
+		diags.AddAttributeError(
+			path.Root("patch"),
+			"Invalid dynamic config patch",
+			"patch must be a non-empty map",
+		)
+		return nil, diags
+	}
+	if empty := findEmptyMap(patch, nil); empty != nil {
+		diags.AddAttributeError(
+			path.Root("patch"),
+			"Invalid dynamic config patch",
+			fmt.Sprintf("patch must not contain empty maps, found one at %q", strings.Join(empty, "/")),
+		)
+		return nil, diags
+	}
+
+	return patch, diags
+}
+
+func NewDynamicConfigResource() resource.Resource {
+	return &dynamicConfigResource{}
+}
+
+func (r *dynamicConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_config"
+}
+
+func (r *dynamicConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A part of a cluster component dynamic config. The patch is deep-merged into the existing config and the resource
+owns only the leaf keys of the patch, so several resources can manage separate parts of the same config.
+Every leaf is written and removed at its own path, maps and lists are not merged as a whole.
+Destroying the resource removes only the owned keys, maps left empty are kept in place.
+
+Targets:
+  node             - //sys/cluster_nodes/@config, top level keys are node filters, for example "%true"
+  scheduler        - //sys/scheduler/config
+  controller_agent - //sys/controller_agents/config
+  master           - //sys/@config
+  http_proxy       - //sys/http_proxies/@config
+  rpc_proxy        - //sys/rpc_proxies/@config
+
+More information:
+https://ytsaurus.tech/docs/en/admin-guide/cluster-operations`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Target name.",
+			},
+			"target": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("node", "scheduler", "controller_agent", "master", "http_proxy", "rpc_proxy"),
+				},
+				Description: "Config to patch: node, scheduler, controller_agent, master, http_proxy or rpc_proxy.",
+			},
+			"patch": schema.StringAttribute{
+				Required:    true,
+				Description: "A JSON or YSON encoded map merged into the config, for example jsonencode({ \"%true\" = { tablet_node = { slots = 2 } } }). The value is compared semantically.",
+			},
+		},
+	}
+}
+
+func (r *dynamicConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *dynamicConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DynamicConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch, diags := toPatch(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := dynamicConfigTargets[plan.Target.ValueString()]
+	if err := r.setLeaves(ctx, target, patch, leafPaths(patch, nil)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dynamic_config",
+			fmt.Sprintf(
+				"Could not patch %q, unexpected error: %q",
+				target.path.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = plan.Target
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dynamicConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DynamicConfigModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch, diags := toPatch(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := dynamicConfigTargets[state.Target.ValueString()]
+	doc, err := r.readConfig(ctx, target)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading dynamic_config",
+			fmt.Sprintf(
+				"Could not read %q, unexpected error: %q",
+				target.path.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	owned := extractOwned(doc, patch)
+	if len(owned) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	current, err := ytsaurus.MarshalValue(owned)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading dynamic_config",
+			fmt.Sprintf("Could not encode config patch, unexpected error: %q", err.Error()),
+		)
+		return
+	}
+	if !ytsaurus.IsEqualValues(state.Patch.ValueString(), current) {
+		state.Patch = types.StringValue(current)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *dynamicConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DynamicConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state DynamicConfigModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch, diags := toPatch(plan)
+	resp.Diagnostics.Append(diags...)
+	statePatch, diags := toPatch(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := dynamicConfigTargets[state.Target.ValueString()]
+	err := r.removeLeaves(ctx, target, removedLeafPaths(statePatch, patch))
+	if err == nil {
+		err = r.setLeaves(ctx, target, patch, leafPaths(patch, nil))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating dynamic_config",
+			fmt.Sprintf(
+				"Could not patch %q, unexpected error: %q",
+				target.path.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dynamicConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DynamicConfigModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch, diags := toPatch(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := dynamicConfigTargets[state.Target.ValueString()]
+	if err := r.removeLeaves(ctx, target, leafPaths(patch, nil)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dynamic_config",
+			fmt.Sprintf(
+				"Could not remove owned keys from %q, unexpected error: %q",
+				target.path.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *dynamicConfigResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		dynamicConfigResourceConfigValidator{},
+	}
+}
+
+func (r *dynamicConfigResource) readConfig(ctx context.Context, target dynamicConfigTarget) (map[string]interface{}, error) {
+	doc := make(map[string]interface{})
+
+	ok, err := r.client.NodeExists(ctx, target.path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return doc, nil
+	}
+
+	var value interface{}
+	if err := r.client.GetNode(ctx, target.path, &value, nil); err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return doc, nil
+	}
+
+	doc, ok = value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%q is not a map", target.path.String())
+	}
+	return doc, nil
+}
+
+func (r *dynamicConfigResource) setLeaves(ctx context.Context, target dynamicConfigTarget, patch map[string]interface{}, leaves [][]string) error {
+	if target.isDocument {
+		createOptions := &yt.CreateNodeOptions{
+			Recursive:      true,
+			IgnoreExisting: true,
+			Attributes: map[string]interface{}{
+				"value": map[string]interface{}{},
+			},
+		}
+		if _, err := r.client.CreateNode(ctx, target.path, yt.NodeDocument, createOptions); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range leaves {
+		if err := r.client.SetNode(ctx, leafYPath(target.path, p), valueAt(patch, p), &yt.SetNodeOptions{Recursive: true}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *dynamicConfigResource) removeLeaves(ctx context.Context, target dynamicConfigTarget, leaves [][]string) error {
+	for _, p := range leaves {
+		if err := ytsaurus.RemoveIfExists(ctx, r.client, leafYPath(target.path, p)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package dynamicconfig
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+type dynamicConfigResourceConfigValidator struct{}
+
+var _ resource.ConfigValidator = &dynamicConfigResourceConfigValidator{}
+
+func (v dynamicConfigResourceConfigValidator) Description(_ context.Context) string {
+	return ""
+}
+
+func (v dynamicConfigResourceConfigValidator) MarkdownDescription(_ context.Context) string {
+	return ""
+}
+
+func (v dynamicConfigResourceConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DynamicConfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := toPatch(config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,138 @@
+package dynamicconfig
+
+import (
+	"sort"
+	"strings"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+)
+
+// leafPaths returns the paths of all non-map values of patch, lists are leaves as a whole.
+// The result is sorted to keep the order of cluster writes stable.
+func leafPaths(patch map[string]interface{}, prefix []string) [][]string {
+	var paths [][]string
+	for k, v := range patch {
+		p := append(append([]string{}, prefix...), k)
+		if vm, ok := v.(map[string]interface{}); ok {
+			paths = append(paths, leafPaths(vm, p)...)
+			continue
+		}
+		paths = append(paths, p)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		return strings.Join(paths[i], "/") < strings.Join(paths[j], "/")
+	})
+	return paths
+}
+
+func hasLeafPath(patch map[string]interface{}, p []string) bool {
+	for _, k := range leafPaths(patch, nil) {
+		if len(k) != len(p) {
+			continue
+		}
+		equal := true
+		for i := range k {
+			if k[i] != p[i] {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return true
+		}
+	}
+	return false
+}
+
+// removedLeafPaths returns the leaves of oldPatch that are not present in newPatch.
+func removedLeafPaths(oldPatch, newPatch map[string]interface{}) [][]string {
+	var removed [][]string
+	for _, p := range leafPaths(oldPatch, nil) {
+		if !hasLeafPath(newPatch, p) {
+			removed = append(removed, p)
+		}
+	}
+	return removed
+}
+
+func valueAt(patch map[string]interface{}, p []string) interface{} {
+	var value interface{} = patch
+	for _, k := range p {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[k]
+	}
+	return value
+}
+
+// findEmptyMap returns the path of the first empty map in patch, empty maps have no leaves to own.
+func findEmptyMap(patch map[string]interface{}, prefix []string) []string {
+	keys := make([]string, 0, len(patch))
+	for k := range patch {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		vm, ok := patch[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		p := append(append([]string{}, prefix...), k)
+		if len(vm) == 0 {
+			return p
+		}
+		if empty := findEmptyMap(vm, p); empty != nil {
+			return empty
+		}
+	}
+	return nil
+}
+
+// extractOwned returns the part of doc covered by the leaves of patch.
+func extractOwned(doc, patch map[string]interface{}) map[string]interface{} {
+	owned := make(map[string]interface{})
+	for k, v := range patch {
+		current, ok := doc[k]
+		if !ok {
+			continue
+		}
+
+		vm, isPatchMap := v.(map[string]interface{})
+		cm, isDocMap := current.(map[string]interface{})
+		if isPatchMap {
+			if !isDocMap {
+				continue
+			}
+			if sub := extractOwned(cm, vm); len(sub) > 0 {
+				owned[k] = sub
+			}
+			continue
+		}
+		owned[k] = current
+	}
+	return owned
+}
+
+var ypathEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`/`, `\/`,
+	`@`, `\@`,
+	`&`, `\&`,
+	`*`, `\*`,
+	`[`, `\[`,
+	`{`, `\{`,
+)
+
+func escapeKey(k string) string {
+	return ypathEscaper.Replace(k)
+}
+
+func leafYPath(root ypath.Path, p []string) ypath.Path {
+	for _, k := range p {
+		root = root.Child(escapeKey(k))
+	}
+	return root
+}
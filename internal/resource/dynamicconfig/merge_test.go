@@ -0,0 +1,124 @@
+package dynamicconfig
+
+import (
+	"reflect"
+	"testing"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+)
+
+func testPatch() map[string]interface{} {
+	return map[string]interface{}{
+		"%true": map[string]interface{}{
+			"tablet_node": map[string]interface{}{
+				"slots": int64(2),
+			},
+			"tags": []interface{}{"a", "b"},
+		},
+		"enabled": true,
+	}
+}
+
+func TestLeafPaths(t *testing.T) {
+	expected := [][]string{
+		{"%true", "tablet_node", "slots"},
+		{"%true", "tags"},
+		{"enabled"},
+	}
+	if paths := leafPaths(testPatch(), nil); !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("leafPaths() = %v, expected %v", paths, expected)
+	}
+}
+
+func TestRemovedLeafPaths(t *testing.T) {
+	newPatch := map[string]interface{}{
+		"%true": map[string]interface{}{
+			"tags": []interface{}{"c"},
+		},
+		"enabled": map[string]interface{}{
+			"value": true,
+		},
+	}
+
+	expected := [][]string{
+		{"%true", "tablet_node", "slots"},
+		{"enabled"},
+	}
+	if removed := removedLeafPaths(testPatch(), newPatch); !reflect.DeepEqual(removed, expected) {
+		t.Fatalf("removedLeafPaths() = %v, expected %v", removed, expected)
+	}
+	if removed := removedLeafPaths(testPatch(), testPatch()); len(removed) != 0 {
+		t.Fatalf("removedLeafPaths() of equal patches = %v, expected none", removed)
+	}
+}
+
+func TestValueAt(t *testing.T) {
+	patch := testPatch()
+	if v := valueAt(patch, []string{"%true", "tablet_node", "slots"}); v != int64(2) {
+		t.Fatalf("valueAt() = %v, expected 2", v)
+	}
+	if v := valueAt(patch, []string{"%true", "tags"}); !reflect.DeepEqual(v, []interface{}{"a", "b"}) {
+		t.Fatalf("valueAt() = %v, expected [a b]", v)
+	}
+	if v := valueAt(patch, []string{"enabled", "value"}); v != nil {
+		t.Fatalf("valueAt() through a leaf = %v, expected nil", v)
+	}
+}
+
+func TestFindEmptyMap(t *testing.T) {
+	if p := findEmptyMap(testPatch(), nil); p != nil {
+		t.Fatalf("findEmptyMap() = %v, expected nil", p)
+	}
+
+	patch := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": map[string]interface{}{},
+		},
+		"c": int64(1),
+	}
+	if p := findEmptyMap(patch, nil); !reflect.DeepEqual(p, []string{"a", "b"}) {
+		t.Fatalf("findEmptyMap() = %v, expected [a b]", p)
+	}
+}
+
+func TestExtractOwned(t *testing.T) {
+	doc := map[string]interface{}{
+		"%true": map[string]interface{}{
+			"tablet_node": map[string]interface{}{
+				"slots":        int64(4),
+				"foreign_slot": int64(1),
+			},
+			"foreign": "x",
+		},
+		"foreign": map[string]interface{}{
+			"enabled": false,
+		},
+		"enabled": false,
+	}
+
+	expected := map[string]interface{}{
+		"%true": map[string]interface{}{
+			"tablet_node": map[string]interface{}{
+				"slots": int64(4),
+			},
+		},
+		"enabled": false,
+	}
+	if owned := extractOwned(doc, testPatch()); !reflect.DeepEqual(owned, expected) {
+		t.Fatalf("extractOwned() = %v, expected %v", owned, expected)
+	}
+
+	delete(doc, "enabled")
+	doc["%true"] = "replaced"
+	if owned := extractOwned(doc, testPatch()); len(owned) != 0 {
+		t.Fatalf("extractOwned() of a config without owned keys = %v, expected empty", owned)
+	}
+}
+
+func TestLeafYPath(t *testing.T) {
+	p := leafYPath(ypath.Path("//sys/cluster_nodes/@config"), []string{"%true", "a/b", "@c", "d"})
+	expected := `//sys/cluster_nodes/@config/%true/a\/b/\@c/d`
+	if p.String() != expected {
+		t.Fatalf("leafYPath() = %q, expected %q", p.String(), expected)
+	}
+}
@@ -0,0 +1,673 @@
+package dynamictable
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const (
+	defaultTabletCellBundle = "default"
+	defaultInMemoryMode     = "none"
+	defaultState            = yt.TabletMounted
+)
+
+type dynamicTableResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                     = &dynamicTableResource{}
+	_ resource.ResourceWithConfigure        = &dynamicTableResource{}
+	_ resource.ResourceWithImportState      = &dynamicTableResource{}
+	_ resource.ResourceWithConfigValidators = &dynamicTableResource{}
+	_ resource.ResourceWithModifyPlan       = &dynamicTableResource{}
+)
+
+type DynamicTableModel struct {
+	ID               types.String                  `tfsdk:"id"`
+	Path             types.String                  `tfsdk:"path"`
+	Schema           *tableschema.TableSchemaModel `tfsdk:"schema"`
+	TabletCellBundle types.String                  `tfsdk:"tablet_cell_bundle"`
+	PivotKeys        types.String                  `tfsdk:"pivot_keys"`
+	TabletCount      types.Int64                   `tfsdk:"tablet_count"`
+	InMemoryMode     types.String                  `tfsdk:"in_memory_mode"`
+	State            types.String                  `tfsdk:"state"`
+	Account          types.String                  `tfsdk:"account"`
+	InheritACL       types.Bool                    `tfsdk:"inherit_acl"`
+	ACL              acl.ACLModel                  `tfsdk:"acl"`
+}
+
+func toDynamicTableModel(t ytsaurus.DynamicTable) (DynamicTableModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	table := DynamicTableModel{
+		ID:               types.StringValue(t.ID),
+		Path:             types.StringValue(t.Path),
+		Schema:           tableschema.ToTableSchemaModel(t.Schema),
+		TabletCellBundle: types.StringValue(t.TabletCellBundle),
+		TabletCount:      types.Int64Value(t.TabletCount),
+		InMemoryMode:     types.StringValue(t.InMemoryMode),
+		State:            types.StringValue(t.TabletState),
+		Account:          types.StringValue(t.Account),
+		InheritACL:       types.BoolValue(t.InheritACL),
+		ACL:              acl.ToACLModel(t.ACL),
+	}
+
+	if t.PivotKeys != nil {
+		pivotKeys, err := ytsaurus.MarshalValue(t.PivotKeys)
+		if err != nil {
+			diags.AddError(
+				"Error reading dynamic_table",
+				fmt.Sprintf("Could not encode pivot_keys, unexpected error: %q", err.Error()),
+			)
+		}
+		table.PivotKeys = types.StringValue(pivotKeys)
+	} else {
+		table.PivotKeys = types.StringNull()
+	}
+
+	return table, diags
+}
+
+func toYTsaurusDynamicTable(t DynamicTableModel) (ytsaurus.DynamicTable, diag.Diagnostics) {
+	acl, diags := acl.ToYTsaurusACL(t.ACL)
+	table := ytsaurus.DynamicTable{
+		ID:               t.ID.ValueString(),
+		Path:             t.Path.ValueString(),
+		Schema:           tableschema.ToYTsaurusSchema(t.Schema),
+		TabletCellBundle: t.TabletCellBundle.ValueString(),
+		TabletCount:      t.TabletCount.ValueInt64(),
+		InMemoryMode:     t.InMemoryMode.ValueString(),
+		TabletState:      t.State.ValueString(),
+		Account:          t.Account.ValueString(),
+		InheritACL:       t.InheritACL.ValueBool(),
+		ACL:              acl,
+	}
+
+	if !t.PivotKeys.IsNull() && !t.PivotKeys.IsUnknown() {
+		pivotKeys, err := ytsaurus.UnmarshalValue(t.PivotKeys.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("pivot_keys"),
+				"Invalid pivot_keys",
+				err.Error(),
+			)
+			return table, diags
+		}
+		keys, ok := pivotKeys.([]interface{})
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("pivot_keys"),
+				"Invalid pivot_keys",
+				"pivot_keys must be a list of keys",
+			)
+			return table, diags
+		}
+		table.PivotKeys = keys
+	}
+
+	return table, diags
+}
+
+func NewDynamicTableResource() resource.Resource {
+	return &dynamicTableResource{}
+}
+
+func (r *dynamicTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_table"
+}
+
+func (r *dynamicTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Dynamic tables implement key-value (sorted) and queue (ordered) storage on top of tablet cells.
+A table is sorted when at least one of its schema columns has a sort_order.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/dynamic-tables/overview`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Table absolute path.",
+			},
+			"schema": schema.SingleNestedAttribute{
+				Required:    true,
+				Attributes:  tableschema.TableSchemaAttributes,
+				Description: "Table schema. More information: https://ytsaurus.tech/docs/en/user-guide/dynamic-tables/overview#schema.",
+			},
+			"tablet_cell_bundle": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultTabletCellBundle),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "A tablet_cell_bundle to serve the table's tablets. Changing the bundle unmounts the table.",
+			},
+			"pivot_keys": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("tablet_count")),
+				},
+				Description: "A JSON-encoded list of tablet pivot keys of a sorted table, the first key must be empty, for example jsonencode([[], [100], [200]]). Changing pivot keys unmounts and reshards the table.",
+			},
+			"tablet_count": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Number of tablets. Changing the number of tablets unmounts and reshards the table.",
+			},
+			"in_memory_mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultInMemoryMode),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"none",
+						"compressed",
+						"uncompressed",
+					),
+				},
+				Description: "Whether table chunks are kept in tablet nodes memory, can be 'none', 'compressed' or 'uncompressed'.",
+			},
+			"state": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultState),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						yt.TabletMounted,
+						yt.TabletUnmounted,
+						yt.TabletFrozen,
+					),
+				},
+				Description: "The desired tablet state of the table, can be 'mounted', 'unmounted' or 'frozen'.",
+			},
+			"account": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Account used to keep track of the resources being used by the table.",
+			},
+			"inherit_acl": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Enable or disable ACL inheritance from an object's parents.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+		},
+	}
+}
+
+func (r *dynamicTableResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+// ModifyPlan makes unique_keys default to true for sorted tables, sorted dynamic tables require unique keys.
+// It also drops the stored value of pivot_keys or tablet_count when the other one is resharding the table.
+func (r *dynamicTableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config DynamicTableModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(tableschema.SetSortedUniqueKeysDefault(ctx, config.Schema, path.Root("schema"), &resp.Plan)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state DynamicTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.PivotKeys.IsNull() && !config.TabletCount.IsNull() && !config.TabletCount.Equal(state.TabletCount) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pivot_keys"), types.StringUnknown())...)
+	}
+	if config.TabletCount.IsNull() && !config.PivotKeys.IsNull() && !config.PivotKeys.IsUnknown() &&
+		(state.PivotKeys.IsNull() || !ytsaurus.IsEqualValues(config.PivotKeys.ValueString(), state.PivotKeys.ValueString())) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tablet_count"), types.Int64Unknown())...)
+	}
+}
+
+func (r *dynamicTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DynamicTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytTable, diags := toYTsaurusDynamicTable(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateNodeOptions{
+		Attributes: map[string]interface{}{
+			"dynamic":            true,
+			"schema":             ytTable.Schema,
+			"tablet_cell_bundle": ytTable.TabletCellBundle,
+			"in_memory_mode":     ytTable.InMemoryMode,
+			"acl":                ytTable.ACL,
+			"inherit_acl":        ytTable.InheritACL,
+			"terraform_resource": true,
+		},
+	}
+	if ytTable.Account != "" {
+		createOptions.Attributes["account"] = ytTable.Account
+	}
+
+	p := ypath.Path(ytTable.Path)
+	id, err := r.client.CreateNode(ctx, p, yt.NodeTable, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dynamic_table",
+			fmt.Sprintf(
+				"Could not create dynamic_table %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	ytTable.ID = id.String()
+
+	if ytTable.PivotKeys != nil || ytTable.TabletCount > 0 {
+		if err := r.reshard(ctx, ytTable); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating dynamic_table",
+				fmt.Sprintf(
+					"Could not reshard dynamic_table %q, unexpected error: %q",
+					p.String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	if err := ytsaurus.SetTabletState(ctx, r.client, p, yt.TabletUnmounted, ytTable.TabletState, false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dynamic_table",
+			fmt.Sprintf(
+				"Could not set dynamic_table %q state to %q, unexpected error: %q",
+				p.String(),
+				ytTable.TabletState,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(ytTable.ID)
+	resp.Diagnostics.Append(r.setComputedAttributes(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dynamicTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DynamicTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytTable, err := r.read(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading dynamic_table",
+			fmt.Sprintf(
+				"Could not read dynamic_table with id %q, unexpected error: %q",
+				state.ID.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	newState, diags := toDynamicTableModel(ytTable)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.PivotKeys.IsNull() && !newState.PivotKeys.IsNull() &&
+		ytsaurus.IsEqualValues(state.PivotKeys.ValueString(), newState.PivotKeys.ValueString()) {
+		newState.PivotKeys = state.PivotKeys
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *dynamicTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DynamicTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state DynamicTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Path.Equal(state.Path) {
+		resp.Diagnostics.AddError(
+			"Error updating dynamic_table attributes",
+			"Builtin attribute 'path' cannot be updated",
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	ytTablePlan, diags := toYTsaurusDynamicTable(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytTableCurrent, err := r.read(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating dynamic_table",
+			fmt.Sprintf(
+				"Could not read dynamic_table with id %q, unexpected error: %q",
+				state.ID.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	schemaChanged := !tableschema.IsEqual(plan.Schema, state.Schema)
+	bundleChanged := ytTablePlan.TabletCellBundle != ytTableCurrent.TabletCellBundle
+	inMemoryModeChanged := ytTablePlan.InMemoryMode != ytTableCurrent.InMemoryMode
+
+	reshardRequired := false
+	if !plan.TabletCount.IsUnknown() && ytTablePlan.TabletCount != ytTableCurrent.TabletCount {
+		reshardRequired = true
+	}
+	if ytTablePlan.PivotKeys != nil {
+		current, _ := ytsaurus.MarshalValue(ytTableCurrent.PivotKeys)
+		if !ytsaurus.IsEqualValues(plan.PivotKeys.ValueString(), current) {
+			reshardRequired = true
+		}
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	currentState := ytTableCurrent.TabletState
+	if (schemaChanged || bundleChanged || reshardRequired) && currentState != yt.TabletUnmounted {
+		if err := ytsaurus.SetTabletState(ctx, r.client, p, currentState, yt.TabletUnmounted, false); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating dynamic_table",
+				fmt.Sprintf(
+					"Could not unmount dynamic_table %q, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+		currentState = yt.TabletUnmounted
+	}
+
+	if schemaChanged {
+		alterOptions := &yt.AlterTableOptions{
+			Schema: ytTablePlan.Schema,
+		}
+		if err := r.client.AlterTable(ctx, p, alterOptions); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating dynamic_table schema",
+				fmt.Sprintf(
+					"Could not alter dynamic_table %q, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	attributeUpdates := map[string]interface{}{
+		"in_memory_mode": ytTablePlan.InMemoryMode,
+		"account":        ytTablePlan.Account,
+		"acl":            ytTablePlan.ACL,
+		"inherit_acl":    ytTablePlan.InheritACL,
+	}
+	if bundleChanged {
+		attributeUpdates["tablet_cell_bundle"] = ytTablePlan.TabletCellBundle
+	}
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating dynamic_table attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	if reshardRequired {
+		if plan.TabletCount.IsUnknown() {
+			ytTablePlan.TabletCount = 0
+		}
+		if err := r.reshard(ctx, ytTablePlan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating dynamic_table",
+				fmt.Sprintf(
+					"Could not reshard dynamic_table %q, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	if err := ytsaurus.SetTabletState(ctx, r.client, p, currentState, ytTablePlan.TabletState, inMemoryModeChanged); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating dynamic_table",
+			fmt.Sprintf(
+				"Could not set dynamic_table %q state to %q, unexpected error: %q",
+				plan.Path.ValueString(),
+				ytTablePlan.TabletState,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setComputedAttributes(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dynamicTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DynamicTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	var currentState string
+	if err := r.client.GetNode(ctx, p.Attr("tablet_state"), &currentState, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dynamic_table",
+			fmt.Sprintf(
+				"Could not read %q, unexpected error: %q",
+				p.Attr("tablet_state").String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := ytsaurus.SetTabletState(ctx, r.client, p, currentState, yt.TabletUnmounted, false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dynamic_table",
+			fmt.Sprintf(
+				"Could not unmount dynamic_table %q, unexpected error: %q",
+				state.Path.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := r.client.RemoveNode(ctx, ypath.Path(state.Path.ValueString()), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dynamic_table",
+			fmt.Sprintf(
+				"Could not delete dynamic_table %q, unexpected error: %q",
+				state.Path.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *dynamicTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dynamicTableResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		dynamicTableResourceConfigValidator{},
+	}
+}
+
+func (r *dynamicTableResource) read(ctx context.Context, objectID string) (ytsaurus.DynamicTable, error) {
+	var ytTable ytsaurus.DynamicTable
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytTable); err != nil {
+		return ytTable, err
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID))
+	if err := r.client.GetNode(ctx, p.Attr("path"), &ytTable.Path, nil); err != nil {
+		return ytTable, err
+	}
+
+	if tableschema.IsSorted(ytTable.Schema) {
+		if err := r.client.GetNode(ctx, p.Attr("pivot_keys"), &ytTable.PivotKeys, nil); err != nil {
+			return ytTable, err
+		}
+	}
+
+	return ytTable, nil
+}
+
+func (r *dynamicTableResource) setComputedAttributes(ctx context.Context, m *DynamicTableModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ytTable, err := r.read(ctx, m.ID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error reading dynamic_table",
+			fmt.Sprintf(
+				"Could not read dynamic_table with id %q, unexpected error: %q",
+				m.ID.ValueString(),
+				err.Error(),
+			),
+		)
+		return diags
+	}
+
+	current, diags := toDynamicTableModel(ytTable)
+	if diags.HasError() {
+		return diags
+	}
+
+	if m.PivotKeys.IsUnknown() {
+		m.PivotKeys = current.PivotKeys
+	}
+	if m.TabletCount.IsUnknown() {
+		m.TabletCount = current.TabletCount
+	}
+	if m.Account.IsUnknown() {
+		m.Account = current.Account
+	}
+
+	return diags
+}
+
+func (r *dynamicTableResource) reshard(ctx context.Context, t ytsaurus.DynamicTable) error {
+	reshardOptions := &yt.ReshardTableOptions{}
+	if t.PivotKeys != nil {
+		reshardOptions.PivotKeys = t.PivotKeys
+	} else {
+		tabletCount := int(t.TabletCount)
+		reshardOptions.TabletCount = &tabletCount
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", t.ID))
+	return r.client.ReshardTable(ctx, p, reshardOptions)
+}
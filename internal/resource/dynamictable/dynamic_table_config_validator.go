@@ -0,0 +1,72 @@
+package dynamictable
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+)
+
+type dynamicTableResourceConfigValidator struct{}
+
+var _ resource.ConfigValidator = &dynamicTableResourceConfigValidator{}
+
+func (v dynamicTableResourceConfigValidator) Description(_ context.Context) string {
+	return ""
+}
+
+func (v dynamicTableResourceConfigValidator) MarkdownDescription(_ context.Context) string {
+	return ""
+}
+
+func (v dynamicTableResourceConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DynamicTableModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytTable, diags := toYTsaurusDynamicTable(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(tableschema.ValidateSortedUniqueKeys(config.Schema, path.Root("schema"), "Dynamic table configuration error")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.PivotKeys.IsNull() || config.PivotKeys.IsUnknown() {
+		return
+	}
+
+	if ytTable.Schema != nil && !tableschema.IsSorted(ytTable.Schema) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pivot_keys"),
+			"Dynamic table configuration error",
+			"pivot_keys can be set only for sorted tables, use tablet_count for ordered tables",
+		)
+		return
+	}
+
+	if len(ytTable.PivotKeys) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pivot_keys"),
+			"Dynamic table configuration error",
+			"pivot_keys must contain at least one key",
+		)
+		return
+	}
+
+	if firstKey, ok := ytTable.PivotKeys[0].([]interface{}); !ok || len(firstKey) != 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pivot_keys"),
+			"Dynamic table configuration error",
+			"The first pivot key must be an empty list",
+		)
+		return
+	}
+}
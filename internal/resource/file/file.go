@@ -0,0 +1,424 @@
+package file
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type fileResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                = &fileResource{}
+	_ resource.ResourceWithConfigure   = &fileResource{}
+	_ resource.ResourceWithImportState = &fileResource{}
+	_ resource.ResourceWithModifyPlan  = &fileResource{}
+)
+
+type FileModel struct {
+	ID                types.String `tfsdk:"id"`
+	Path              types.String `tfsdk:"path"`
+	Source            types.String `tfsdk:"source"`
+	Content           types.String `tfsdk:"content"`
+	MD5               types.String `tfsdk:"md5"`
+	SHA256            types.String `tfsdk:"sha256"`
+	Executable        types.Bool   `tfsdk:"executable"`
+	ReplicationFactor types.Int64  `tfsdk:"replication_factor"`
+	PrimaryMedium     types.String `tfsdk:"primary_medium"`
+	Account           types.String `tfsdk:"account"`
+}
+
+func getFileContent(m FileModel) ([]byte, error) {
+	if !m.Source.IsNull() {
+		return os.ReadFile(m.Source.ValueString())
+	}
+	return []byte(m.Content.ValueString()), nil
+}
+
+func getFileHashes(data []byte) (string, string) {
+	md5sum := md5.Sum(data)
+	sha256sum := sha256.Sum256(data)
+	return hex.EncodeToString(md5sum[:]), hex.EncodeToString(sha256sum[:])
+}
+
+func NewFileResource() resource.Resource {
+	return &fileResource{}
+}
+
+func (r *fileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (r *fileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A file stored in Cypress, for example a UDF binary or a small configuration file.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/storage/files`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "File absolute path.",
+			},
+			"source": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+				Description: "Path to a local file to upload. Conflicts with content.",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Literal file content to upload. Conflicts with source.",
+			},
+			"md5": schema.StringAttribute{
+				Computed:    true,
+				Description: "MD5 hash of the file content.",
+			},
+			"sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 hash of the file content, the file is re-uploaded when it changes.",
+			},
+			"executable": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Mark the file as executable, required for binaries used in operations.",
+			},
+			"replication_factor": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "How many replicas should be stored for file chunks.",
+			},
+			"primary_medium": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A medium to store file chunks.",
+			},
+			"account": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Account used to keep track of the resources being used by the file.",
+			},
+		},
+	}
+}
+
+func (r *fileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *fileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() || plan.Content.IsUnknown() {
+		plan.MD5 = types.StringUnknown()
+		plan.SHA256 = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+		return
+	}
+
+	data, err := getFileContent(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Error reading file source",
+			fmt.Sprintf(
+				"Could not read %q, unexpected error: %q",
+				plan.Source.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	md5sum, sha256sum := getFileHashes(data)
+	plan.MD5 = types.StringValue(md5sum)
+	plan.SHA256 = types.StringValue(sha256sum)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func (r *fileResource) upload(ctx context.Context, p ypath.Path, m FileModel) error {
+	data, err := getFileContent(m)
+	if err != nil {
+		return err
+	}
+
+	w, err := r.client.WriteFile(ctx, p, &yt.WriteFileOptions{ComputeMD5: true})
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (r *fileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateNodeOptions{
+		Attributes: map[string]interface{}{
+			"executable":         plan.Executable.ValueBool(),
+			"terraform_resource": true,
+		},
+	}
+	for k, v := range map[string]string{
+		"primary_medium": plan.PrimaryMedium.ValueString(),
+		"account":        plan.Account.ValueString(),
+	} {
+		if v != "" {
+			createOptions.Attributes[k] = v
+		}
+	}
+	if plan.ReplicationFactor.ValueInt64() > 0 {
+		createOptions.Attributes["replication_factor"] = plan.ReplicationFactor.ValueInt64()
+	}
+
+	p := ypath.Path(plan.Path.ValueString())
+	id, err := r.client.CreateNode(ctx, p, yt.NodeFile, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file",
+			fmt.Sprintf(
+				"Could not create file %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := r.upload(ctx, p, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file",
+			fmt.Sprintf(
+				"Could not upload file %q content, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		if err := ytsaurus.RemoveIfExists(ctx, r.client, p); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating file",
+				fmt.Sprintf(
+					"Could not remove file %q, unexpected error: %q",
+					p.String(),
+					err.Error(),
+				),
+			)
+		}
+		return
+	}
+
+	var created ytsaurus.File
+	if err := ytsaurus.GetObjectByID(ctx, r.client, id.String(), &created); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file",
+			fmt.Sprintf(
+				"Could not read file %q attributes, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = types.StringValue(id.String())
+	state.PrimaryMedium = types.StringValue(created.PrimaryMedium)
+	state.ReplicationFactor = types.Int64Value(created.ReplicationFactor)
+	state.Account = types.StringValue(created.Account)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *fileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectID := state.ID.ValueString()
+	var ytFile ytsaurus.File
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytFile); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file",
+			fmt.Sprintf(
+				"Could not read file with id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID)).Attr("path")
+	if err := r.client.GetNode(ctx, p, &ytFile.Path, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file @path attribute",
+			fmt.Sprintf(
+				"Could not read file %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(ytFile.ID)
+	state.Path = types.StringValue(ytFile.Path)
+	state.Executable = types.BoolValue(ytFile.Executable)
+	state.PrimaryMedium = types.StringValue(ytFile.PrimaryMedium)
+	state.ReplicationFactor = types.Int64Value(ytFile.ReplicationFactor)
+	state.Account = types.StringValue(ytFile.Account)
+
+	// The content was rewritten outside of terraform, sha256 can't be read
+	// from the cluster so it is reset to make the plan re-upload the file.
+	if ytFile.MD5 != state.MD5.ValueString() {
+		state.MD5 = types.StringValue(ytFile.MD5)
+		state.SHA256 = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *fileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan FileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state FileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Path.Equal(state.Path) {
+		resp.Diagnostics.AddError(
+			"Error updating file attributes",
+			"Builtin attribute 'path' cannot be updated",
+		)
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	attributeUpdates := map[string]interface{}{
+		"executable":         plan.Executable.ValueBool(),
+		"primary_medium":     plan.PrimaryMedium.ValueString(),
+		"replication_factor": plan.ReplicationFactor.ValueInt64(),
+		"account":            plan.Account.ValueString(),
+	}
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating file attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	if !plan.SHA256.Equal(state.SHA256) {
+		if err := r.upload(ctx, p, plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating file",
+				fmt.Sprintf(
+					"Could not upload file %q content, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *fileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(state.Path.ValueString())
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting file",
+			fmt.Sprintf(
+				"Could not delete file %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *fileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -12,6 +14,7 @@ import (
 	"go.ytsaurus.tech/yt/go/ypath"
 	"go.ytsaurus.tech/yt/go/yt"
 
+	"terraform-provider-ytsaurus/internal/set"
 	"terraform-provider-ytsaurus/internal/ytsaurus"
 )
 
@@ -20,21 +23,40 @@ type groupResource struct {
 }
 
 type GroupModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Members types.Set    `tfsdk:"members"`
 }
 
 func toGroupModel(g ytsaurus.Group) GroupModel {
-	return GroupModel{
+	group := GroupModel{
 		ID:   types.StringValue(g.ID),
 		Name: types.StringValue(g.Name),
 	}
+
+	if g.Members != nil && len(*g.Members) > 0 {
+		var members []attr.Value
+		for _, m := range *g.Members {
+			members = append(members, types.StringValue(m))
+		}
+		group.Members = types.SetValueMust(types.StringType, members)
+	} else {
+		group.Members = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+	return group
 }
 
-func toYTsaurusGroup(g GroupModel) ytsaurus.Group {
-	return ytsaurus.Group{
+func toYTsaurusGroup(ctx context.Context, g GroupModel) (ytsaurus.Group, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ytGroup := ytsaurus.Group{
 		Name: g.Name.ValueString(),
 	}
+	if !g.Members.IsNull() && !g.Members.IsUnknown() {
+		var members []string
+		diags = g.Members.ElementsAs(ctx, &members, false)
+		ytGroup.Members = &members
+	}
+	return ytGroup, diags
 }
 
 var (
@@ -79,10 +101,29 @@ https://ytsaurus.tech/docs/en/user-guide/storage/access-control#users_groups
 				Required:    true,
 				Description: "YTsaurus group name.",
 			},
+			"members": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "An authoritative set of group members, users or other groups. Members added outside of Terraform are reported as drift. If omitted, group members are not managed.",
+			},
 		},
 	}
 }
 
+func (r *groupResource) removeCreatedGroup(ctx context.Context, id yt.NodeID, diags *diag.Diagnostics) {
+	p := ypath.Path(fmt.Sprintf("#%s", id.String()))
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		diags.AddError(
+			"Error removing partially created group",
+			fmt.Sprintf(
+				"Could not remove group %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+	}
+}
+
 func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan GroupModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -90,7 +131,12 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	ytGroup := toYTsaurusGroup(plan)
+	ytGroup, diags := toYTsaurusGroup(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createOptions := &yt.CreateObjectOptions{
 		Attributes: map[string]interface{}{
 			"name":               ytGroup.Name,
@@ -110,6 +156,24 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	if ytGroup.Members != nil {
+		for _, member := range *ytGroup.Members {
+			if err := r.client.AddMember(ctx, ytGroup.Name, member, nil); err != nil {
+				resp.Diagnostics.AddError(
+					"Error adding member to group",
+					fmt.Sprintf(
+						"Could not add %q to the group %q, unexpected error: %q",
+						member,
+						ytGroup.Name,
+						err.Error(),
+					),
+				)
+				r.removeCreatedGroup(ctx, id, &resp.Diagnostics)
+				return
+			}
+		}
+	}
+
 	plan.ID = types.StringValue(id.String())
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -121,6 +185,12 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	var members types.Set
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("members"), &members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var group ytsaurus.Group
 	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &group); err != nil {
 		resp.Diagnostics.AddError(
@@ -135,6 +205,10 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	state := toGroupModel(group)
+	if members.IsNull() {
+		// Members are not managed by the resource, e.g. they come from ytsaurus_group_membership.
+		state.Members = types.SetNull(types.StringType)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -145,13 +219,19 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	var objectID string
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	var state GroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectID := state.ID.ValueString()
+	ytGroup, diags := toYTsaurusGroup(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ytGroup := toYTsaurusGroup(plan)
 	p := ypath.Path(fmt.Sprintf("#%s", objectID)).Attr("name")
 	if err := r.client.SetNode(ctx, p, ytGroup.Name, nil); err != nil {
 		resp.Diagnostics.AddError(
@@ -166,6 +246,57 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if ytGroup.Members != nil {
+		// Diff against the live members, state is null when members are adopted or after import.
+		var currentMembers []string
+		p := ypath.Path(fmt.Sprintf("#%s", objectID)).Attr("members")
+		if err := r.client.GetNode(ctx, p, &currentMembers, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating group members",
+				fmt.Sprintf(
+					"Could not read %q, unexpected error: %q",
+					p.String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+		currentMembersSet := set.ToStringSet(currentMembers)
+		planMembersSet := set.ToStringSet(*ytGroup.Members)
+
+		removeMembers := currentMembersSet.Difference(planMembersSet)
+		for _, member := range removeMembers {
+			if err := r.client.RemoveMember(ctx, ytGroup.Name, member, nil); err != nil {
+				resp.Diagnostics.AddError(
+					"Error removing member from group",
+					fmt.Sprintf(
+						"Could not remove %q from %q group, unexpected error: %q",
+						member,
+						ytGroup.Name,
+						err.Error(),
+					),
+				)
+				return
+			}
+		}
+
+		addMembers := planMembersSet.Difference(currentMembersSet)
+		for _, member := range addMembers {
+			if err := r.client.AddMember(ctx, ytGroup.Name, member, nil); err != nil {
+				resp.Diagnostics.AddError(
+					"Error adding member to group",
+					fmt.Sprintf(
+						"Could not add %q to %q group, unexpected error: %q",
+						member,
+						ytGroup.Name,
+						err.Error(),
+					),
+				)
+				return
+			}
+		}
+	}
+
 	plan.ID = types.StringValue(objectID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -177,8 +308,7 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	ytGroup := toYTsaurusGroup(state)
-	p := ypath.Path(fmt.Sprintf("//sys/groups/%s", ytGroup.Name))
+	p := ypath.Path(fmt.Sprintf("//sys/groups/%s", state.Name.ValueString()))
 	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting group",
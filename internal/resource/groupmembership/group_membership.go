@@ -0,0 +1,224 @@
+package groupmembership
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/set"
+)
+
+type groupMembershipResource struct {
+	client yt.Client
+}
+
+type GroupMembershipModel struct {
+	ID     types.String `tfsdk:"id"`
+	Group  types.String `tfsdk:"group"`
+	Member types.String `tfsdk:"member"`
+}
+
+var (
+	_ resource.Resource                = &groupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &groupMembershipResource{}
+	_ resource.ResourceWithImportState = &groupMembershipResource{}
+)
+
+func NewGroupMembershipResource() resource.Resource {
+	return &groupMembershipResource{}
+}
+
+func toGroupMembershipID(group, member string) string {
+	return fmt.Sprintf("%s/%s", group, member)
+}
+
+func (r *groupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *groupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *groupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A single (group, member) pair. The resource is non-authoritative: other members of the group,
+including ones added outside of Terraform, are left untouched.
+The member can be either a user or another group.
+
+Import id has the form 'group/member'.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/storage/access-control#users_groups`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Membership identifier in the form 'group/member'.",
+			},
+			"group": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "YTsaurus group name.",
+			},
+			"member": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "YTsaurus user or group name to add to the group.",
+			},
+		},
+	}
+}
+
+func (r *groupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GroupMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := plan.Group.ValueString()
+	memberName := plan.Member.ValueString()
+	if err := r.client.AddMember(ctx, groupName, memberName, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding member to group",
+			fmt.Sprintf(
+				"Could not add %q to the group %q, unexpected error: %q",
+				memberName,
+				groupName,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(toGroupMembershipID(groupName, memberName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *groupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GroupMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := state.Group.ValueString()
+	memberName := state.Member.ValueString()
+	p := ypath.Path(fmt.Sprintf("//sys/groups/%s", groupName))
+	ok, err := r.client.NodeExists(ctx, p, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading group membership",
+			fmt.Sprintf(
+				"Could not check group %q existence, unexpected error: %q",
+				groupName,
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var members []string
+	if err := r.client.GetNode(ctx, p.Attr("members"), &members, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading group membership",
+			fmt.Sprintf(
+				"Could not read members of the group %q, unexpected error: %q",
+				groupName,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if !set.ToStringSet(members).Contains(memberName) {
+		// The membership was revoked outside of Terraform, the plan will add it again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(toGroupMembershipID(groupName, memberName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *groupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Both group and member require replacement, there is nothing to update in place.
+	var plan GroupMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(toGroupMembershipID(plan.Group.ValueString(), plan.Member.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *groupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GroupMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := state.Group.ValueString()
+	memberName := state.Member.ValueString()
+	if err := r.client.RemoveMember(ctx, groupName, memberName, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error removing member from group",
+			fmt.Sprintf(
+				"Could not remove %q from the group %q, unexpected error: %q",
+				memberName,
+				groupName,
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *groupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Error importing group membership",
+			fmt.Sprintf("Expected import id in the form 'group/member', got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member"), parts[1])...)
+}
@@ -0,0 +1,239 @@
+package hostrack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type hostRackResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                = &hostRackResource{}
+	_ resource.ResourceWithConfigure   = &hostRackResource{}
+	_ resource.ResourceWithImportState = &hostRackResource{}
+)
+
+type HostRackModel struct {
+	ID   types.String `tfsdk:"id"`
+	Host types.String `tfsdk:"host"`
+	Rack types.String `tfsdk:"rack"`
+}
+
+func hostPath(host string) ypath.Path {
+	return ypath.Path("//sys/hosts").Child(host)
+}
+
+func NewHostRackResource() resource.Resource {
+	return &hostRackResource{}
+}
+
+func (r *hostRackResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_rack"
+}
+
+func (r *hostRackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Binds an existing cluster host from //sys/hosts to a rack. All nodes running on the host are placed in the rack.
+Destroying the resource unbinds the host, the host itself is never removed.
+
+More information:
+https://ytsaurus.tech/docs/en/admin-guide/cluster-topology`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Host name, the same as host.",
+			},
+			"host": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Host name as registered under //sys/hosts.",
+			},
+			"rack": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Rack name, for example ytsaurus_rack.<name>.name.",
+			},
+		},
+	}
+}
+
+func (r *hostRackResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *hostRackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan HostRackModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := hostPath(plan.Host.ValueString())
+	ok, err := r.client.NodeExists(ctx, p, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating host_rack",
+			fmt.Sprintf(
+				"Could not check host %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error creating host_rack",
+			fmt.Sprintf("Host %q is not registered", plan.Host.ValueString()),
+		)
+		return
+	}
+
+	if err := r.client.SetNode(ctx, p.Attr("rack"), plan.Rack.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating host_rack",
+			fmt.Sprintf(
+				"Could not set node %q to %q, unexpected error: %q",
+				p.Attr("rack").String(),
+				plan.Rack.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = plan.Host
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *hostRackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state HostRackModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host := state.ID.ValueString()
+	p := hostPath(host)
+	ok, err := r.client.NodeExists(ctx, p.Attr("rack"), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading host_rack",
+			fmt.Sprintf(
+				"Could not check host %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	hostRack := ytsaurus.HostRack{Name: host}
+	if err := r.client.GetNode(ctx, p.Attr("rack"), &hostRack.Rack, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading host_rack",
+			fmt.Sprintf(
+				"Could not read host %q rack, unexpected error: %q",
+				host,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state = HostRackModel{
+		ID:   types.StringValue(hostRack.Name),
+		Host: types.StringValue(hostRack.Name),
+		Rack: types.StringValue(hostRack.Rack),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *hostRackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan HostRackModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state HostRackModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := hostPath(state.ID.ValueString()).Attr("rack")
+	if err := r.client.SetNode(ctx, p, plan.Rack.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating host_rack",
+			fmt.Sprintf(
+				"Could not set node %q to %q, unexpected error: %q",
+				p.String(),
+				plan.Rack.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *hostRackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state HostRackModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := hostPath(state.ID.ValueString()).Attr("rack")
+	if err := ytsaurus.RemoveIfExists(ctx, r.client, p); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting host_rack",
+			fmt.Sprintf(
+				"Could not remove attribute %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *hostRackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), req.ID)...)
+}
@@ -0,0 +1,238 @@
+package link
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type linkResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                = &linkResource{}
+	_ resource.ResourceWithConfigure   = &linkResource{}
+	_ resource.ResourceWithImportState = &linkResource{}
+)
+
+type LinkModel struct {
+	ID         types.String `tfsdk:"id"`
+	Path       types.String `tfsdk:"path"`
+	TargetPath types.String `tfsdk:"target_path"`
+	Recursive  types.Bool   `tfsdk:"recursive"`
+	Force      types.Bool   `tfsdk:"force"`
+}
+
+func NewLinkResource() resource.Resource {
+	return &linkResource{}
+}
+
+func (r *linkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_link"
+}
+
+func (r *linkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A symbolic link to another Cypress node.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/storage/links`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in link's @id attribute. The id changes when the link is re-pointed.",
+			},
+			"path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Link absolute path.",
+			},
+			"target_path": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Absolute path of the link target. Changing the target re-points the link in place.",
+			},
+			"recursive": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Create missing parent nodes of the link.",
+			},
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Replace an existing node at the link path on creation.",
+			},
+		},
+	}
+}
+
+func (r *linkResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *linkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan LinkModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkOptions := &yt.LinkNodeOptions{
+		Recursive: plan.Recursive.ValueBool(),
+		Force:     plan.Force.ValueBool(),
+		Attributes: map[string]interface{}{
+			"terraform_resource": true,
+		},
+	}
+
+	p := ypath.Path(plan.Path.ValueString())
+	target := ypath.Path(plan.TargetPath.ValueString())
+	id, err := r.client.LinkNode(ctx, target, p, linkOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating link",
+			fmt.Sprintf(
+				"Could not create link %q to %q, unexpected error: %q",
+				p.String(),
+				target.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *linkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LinkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectID := state.ID.ValueString()
+	var link ytsaurus.Link
+	p := ypath.Path(fmt.Sprintf("#%s", objectID)).SuppressSymlink().Attrs()
+	if err := r.client.GetNode(ctx, p, &link, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading link",
+			fmt.Sprintf(
+				"Could not read link with id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(link.ID)
+	state.Path = types.StringValue(link.Path)
+	if link.Broken {
+		// The link target is missing, an empty target_path makes the plan re-point the link.
+		state.TargetPath = types.StringValue("")
+	} else {
+		state.TargetPath = types.StringValue(link.TargetPath)
+	}
+	if state.Recursive.IsNull() {
+		state.Recursive = types.BoolValue(false)
+	}
+	if state.Force.IsNull() {
+		state.Force = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *linkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan LinkModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state LinkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	if !plan.TargetPath.Equal(state.TargetPath) {
+		linkOptions := &yt.LinkNodeOptions{
+			Recursive: plan.Recursive.ValueBool(),
+			Force:     true,
+			Attributes: map[string]interface{}{
+				"terraform_resource": true,
+			},
+		}
+
+		p := ypath.Path(plan.Path.ValueString())
+		target := ypath.Path(plan.TargetPath.ValueString())
+		id, err := r.client.LinkNode(ctx, target, p, linkOptions)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating link",
+				fmt.Sprintf(
+					"Could not re-point link %q to %q, unexpected error: %q",
+					p.String(),
+					target.String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+		plan.ID = types.StringValue(id.String())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *linkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LinkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(state.Path.ValueString()).SuppressSymlink()
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting link",
+			fmt.Sprintf(
+				"Could not delete link %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *linkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
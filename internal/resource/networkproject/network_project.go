@@ -0,0 +1,278 @@
+package networkproject
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const (
+	maxProjectID = 4294967295
+
+	nodeTypeNetworkProject yt.NodeType = "network_project"
+)
+
+type networkProjectResource struct {
+	client yt.Client
+}
+
+type NetworkProjectModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	ProjectID types.Int64  `tfsdk:"project_id"`
+	ACL       acl.ACLModel `tfsdk:"acl"`
+}
+
+func toNetworkProjectModel(p ytsaurus.NetworkProject) NetworkProjectModel {
+	return NetworkProjectModel{
+		ID:        types.StringValue(p.ID),
+		Name:      types.StringValue(p.Name),
+		ProjectID: types.Int64Value(p.ProjectID),
+		ACL:       acl.ToACLModel(p.ACL),
+	}
+}
+
+func toYTsaurusNetworkProject(p NetworkProjectModel) (ytsaurus.NetworkProject, diag.Diagnostics) {
+	acl, diags := acl.ToYTsaurusACL(p.ACL)
+	return ytsaurus.NetworkProject{
+		ID:        p.ID.ValueString(),
+		Name:      p.Name.ValueString(),
+		ProjectID: p.ProjectID.ValueInt64(),
+		ACL:       acl,
+	}, diags
+}
+
+var (
+	_ resource.Resource                = &networkProjectResource{}
+	_ resource.ResourceWithConfigure   = &networkProjectResource{}
+	_ resource.ResourceWithImportState = &networkProjectResource{}
+)
+
+func NewNetworkProjectResource() resource.Resource {
+	return &networkProjectResource{}
+}
+
+func (r *networkProjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_project"
+}
+
+func (r *networkProjectResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *networkProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A network project assigns a project id to the network of jobs, the 'use' permission in the project ACL
+allows users to run operations inside the project. Network projects are stored under //sys/network_projects.
+
+The resource can be imported by either the object id or the network project name.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/data-processing/operations/operations-options`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Network project name.",
+			},
+			"project_id": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, maxProjectID),
+				},
+				Description: "Network project id, an unsigned 32-bit integer.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+		},
+	}
+}
+
+func (r *networkProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NetworkProjectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytNetworkProject, diags := toYTsaurusNetworkProject(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateObjectOptions{
+		Attributes: map[string]interface{}{
+			"name":               ytNetworkProject.Name,
+			"project_id":         ytNetworkProject.ProjectID,
+			"acl":                ytNetworkProject.ACL,
+			"terraform_resource": true,
+		},
+	}
+
+	id, err := r.client.CreateObject(ctx, nodeTypeNetworkProject, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating network_project",
+			fmt.Sprintf(
+				"Could not create network_project %q, unexpected error: %q",
+				ytNetworkProject.Name,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *networkProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ytNetworkProject ytsaurus.NetworkProject
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytNetworkProject); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading network_project",
+			fmt.Sprintf(
+				"Could not read network_project by id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := toNetworkProjectModel(ytNetworkProject)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *networkProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NetworkProjectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytNetworkProject, diags := toYTsaurusNetworkProject(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID))
+	attributeUpdates := map[string]interface{}{
+		"project_id": ytNetworkProject.ProjectID,
+		"acl":        ytNetworkProject.ACL,
+	}
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating network_project attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(objectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *networkProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworkProjectModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting network_project",
+			fmt.Sprintf(
+				"Could not delete network_project %q, unexpected error: %q",
+				state.Name.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *networkProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var id yt.NodeID
+	if err := id.UnmarshalText([]byte(req.ID)); err == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	p := ypath.Path("//sys/network_projects").Child(req.ID).Attr("id")
+	var objectID string
+	if err := r.client.GetNode(ctx, p, &objectID, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing network_project",
+			fmt.Sprintf(
+				"Could not read network_project %q, unexpected error: %q",
+				req.ID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), objectID)...)
+}
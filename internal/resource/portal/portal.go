@@ -0,0 +1,330 @@
+package portal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type portalResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                = &portalResource{}
+	_ resource.ResourceWithConfigure   = &portalResource{}
+	_ resource.ResourceWithImportState = &portalResource{}
+)
+
+type PortalModel struct {
+	ID          types.String `tfsdk:"id"`
+	Path        types.String `tfsdk:"path"`
+	ExitCellTag types.Int64  `tfsdk:"exit_cell_tag"`
+	ExitNodeID  types.String `tfsdk:"exit_node_id"`
+	Account     types.String `tfsdk:"account"`
+	InheritACL  types.Bool   `tfsdk:"inherit_acl"`
+	ACL         acl.ACLModel `tfsdk:"acl"`
+}
+
+func toPortalModel(p ytsaurus.Portal) PortalModel {
+	return PortalModel{
+		ID:          types.StringValue(p.ID),
+		Path:        types.StringValue(p.Path),
+		ExitCellTag: types.Int64Value(p.ExitCellTag),
+		ExitNodeID:  types.StringValue(p.ExitNodeID),
+		Account:     types.StringValue(p.Account),
+		InheritACL:  types.BoolValue(p.InheritACL),
+		ACL:         acl.ToACLModel(p.ACL),
+	}
+}
+
+func toYTsaurusPortal(p PortalModel) (ytsaurus.Portal, diag.Diagnostics) {
+	acl, diags := acl.ToYTsaurusACL(p.ACL)
+	return ytsaurus.Portal{
+		Path:        p.Path.ValueString(),
+		ExitCellTag: p.ExitCellTag.ValueInt64(),
+		Account:     p.Account.ValueString(),
+		InheritACL:  p.InheritACL.ValueBool(),
+		ACL:         acl,
+	}, diags
+}
+
+func NewPortalResource() resource.Resource {
+	return &portalResource{}
+}
+
+func (r *portalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_portal"
+}
+
+func (r *portalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A portal moves a Cypress subtree to another master cell. The resource creates a portal_entrance node at path,
+the subtree lives under the portal exit node on the cell with exit_cell_tag. account, acl and inherit_acl are
+managed on the exit node.
+
+More information:
+https://ytsaurus.tech/docs/en/admin-guide/cell-addition`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "ObjectID of the portal entrance, can be found in object's @id attribute.",
+			},
+			"path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Portal entrance absolute path.",
+			},
+			"exit_cell_tag": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "Cell tag of the secondary master cell hosting the portal exit.",
+			},
+			"exit_node_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "ObjectID of the portal exit node.",
+			},
+			"account": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Account used to keep track of the resources being used by the portal subtree.",
+			},
+			"inherit_acl": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Enable or disable ACL inheritance from an object's parents.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+		},
+	}
+}
+
+func (r *portalResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *portalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PortalModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytPortal, diags := toYTsaurusPortal(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateNodeOptions{
+		Attributes: map[string]interface{}{
+			"exit_cell_tag":      ytPortal.ExitCellTag,
+			"acl":                ytPortal.ACL,
+			"inherit_acl":        ytPortal.InheritACL,
+			"terraform_resource": true,
+		},
+	}
+	if ytPortal.Account != "" {
+		createOptions.Attributes["account"] = ytPortal.Account
+	}
+
+	p := ypath.Path(ytPortal.Path)
+	id, err := r.client.CreateNode(ctx, p, yt.NodePortalEntrance, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating portal",
+			fmt.Sprintf(
+				"Could not create portal %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state, err := r.readPortal(ctx, id.String())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating portal",
+			fmt.Sprintf(
+				"Could not read portal %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		// The entrance is not in the state yet, remove it so that the next apply can recreate it.
+		if err := r.client.RemoveNode(ctx, p, &yt.RemoveNodeOptions{Recursive: true}); err != nil {
+			resp.Diagnostics.AddError(
+				"Error removing partially created portal",
+				fmt.Sprintf(
+					"Could not remove portal %q, unexpected error: %q",
+					p.String(),
+					err.Error(),
+				),
+			)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *portalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.readPortal(ctx, objectID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading portal",
+			fmt.Sprintf(
+				"Could not read portal with id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *portalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PortalModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state PortalModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytPortal, diags := toYTsaurusPortal(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ExitNodeID.ValueString()))
+	attributeUpdates := map[string]interface{}{
+		"account":     ytPortal.Account,
+		"acl":         ytPortal.ACL,
+		"inherit_acl": ytPortal.InheritACL,
+	}
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating portal attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.ExitNodeID = state.ExitNodeID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *portalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PortalModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(state.Path.ValueString())
+	if err := r.client.RemoveNode(ctx, p, &yt.RemoveNodeOptions{Recursive: true}); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting portal",
+			fmt.Sprintf(
+				"Could not delete portal %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *portalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readPortal reads the entrance attributes and takes account and ACL from the exit node,
+// since those are the ones applied to the subtree.
+func (r *portalResource) readPortal(ctx context.Context, id string) (PortalModel, error) {
+	var portal ytsaurus.Portal
+	if err := ytsaurus.GetObjectByID(ctx, r.client, id, &portal); err != nil {
+		return PortalModel{}, err
+	}
+
+	var exit ytsaurus.MapNode
+	if err := ytsaurus.GetObjectByID(ctx, r.client, portal.ExitNodeID, &exit); err != nil {
+		return PortalModel{}, err
+	}
+	portal.Account = exit.Account
+	portal.InheritACL = exit.InheritACL
+	portal.ACL = exit.ACL
+
+	return toPortalModel(portal), nil
+}
@@ -0,0 +1,248 @@
+package proxyrole
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const nodeTypeProxyRole yt.NodeType = "proxy_role"
+
+type proxyRoleResource struct {
+	client yt.Client
+}
+
+type ProxyRoleModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	ProxyKind types.String `tfsdk:"proxy_kind"`
+	ACL       acl.ACLModel `tfsdk:"acl"`
+}
+
+func toProxyRoleModel(r ytsaurus.ProxyRole) ProxyRoleModel {
+	return ProxyRoleModel{
+		ID:        types.StringValue(r.ID),
+		Name:      types.StringValue(r.Name),
+		ProxyKind: types.StringValue(r.ProxyKind),
+		ACL:       acl.ToACLModel(r.ACL),
+	}
+}
+
+func toYTsaurusProxyRole(r ProxyRoleModel) (ytsaurus.ProxyRole, diag.Diagnostics) {
+	acl, diags := acl.ToYTsaurusACL(r.ACL)
+	return ytsaurus.ProxyRole{
+		ID:        r.ID.ValueString(),
+		Name:      r.Name.ValueString(),
+		ProxyKind: r.ProxyKind.ValueString(),
+		ACL:       acl,
+	}, diags
+}
+
+var (
+	_ resource.Resource                = &proxyRoleResource{}
+	_ resource.ResourceWithConfigure   = &proxyRoleResource{}
+	_ resource.ResourceWithImportState = &proxyRoleResource{}
+)
+
+func NewProxyRoleResource() resource.Resource {
+	return &proxyRoleResource{}
+}
+
+func (r *proxyRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy_role"
+}
+
+func (r *proxyRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *proxyRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A proxy role groups HTTP or RPC proxies, the 'use' permission in the role ACL controls who can send requests to the role.
+Roles are stored under //sys/http_proxy_roles and //sys/rpc_proxy_roles.
+
+More information:
+https://ytsaurus.tech/docs/en/admin-guide/proxy-roles`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Proxy role name.",
+			},
+			"proxy_kind": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("http", "rpc"),
+				},
+				Description: "Kind of proxies the role is for, 'http' or 'rpc'.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+		},
+	}
+}
+
+func (r *proxyRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProxyRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytProxyRole, diags := toYTsaurusProxyRole(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateObjectOptions{
+		Attributes: map[string]interface{}{
+			"name":               ytProxyRole.Name,
+			"proxy_kind":         ytProxyRole.ProxyKind,
+			"acl":                ytProxyRole.ACL,
+			"terraform_resource": true,
+		},
+	}
+
+	id, err := r.client.CreateObject(ctx, nodeTypeProxyRole, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating proxy_role",
+			fmt.Sprintf(
+				"Could not create proxy_role %q, unexpected error: %q",
+				ytProxyRole.Name,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *proxyRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ytProxyRole ytsaurus.ProxyRole
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytProxyRole); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading proxy_role",
+			fmt.Sprintf(
+				"Could not read proxy_role by id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := toProxyRoleModel(ytProxyRole)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *proxyRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProxyRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytProxyRole, diags := toYTsaurusProxyRole(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID)).Attr("acl")
+	if err := r.client.SetNode(ctx, p, ytProxyRole.ACL, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating proxy_role attributes",
+			fmt.Sprintf(
+				"Could not set node %q to '%v', unexpected error: %q",
+				p.String(),
+				ytProxyRole.ACL,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(objectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *proxyRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProxyRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting proxy_role",
+			fmt.Sprintf(
+				"Could not delete proxy_role %q, unexpected error: %q",
+				state.Name.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *proxyRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,323 @@
+package proxyroleassignment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const idSeparator = "/"
+
+type proxyRoleAssignmentResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                = &proxyRoleAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &proxyRoleAssignmentResource{}
+	_ resource.ResourceWithImportState = &proxyRoleAssignmentResource{}
+)
+
+type ProxyRoleAssignmentModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProxyKind    types.String `tfsdk:"proxy_kind"`
+	Address      types.String `tfsdk:"address"`
+	Role         types.String `tfsdk:"role"`
+	PreviousRole types.String `tfsdk:"previous_role"`
+}
+
+func proxyPath(proxyKind, address string) ypath.Path {
+	return ypath.Path(fmt.Sprintf("//sys/%s_proxies", proxyKind)).Child(address)
+}
+
+func NewProxyRoleAssignmentResource() resource.Resource {
+	return &proxyRoleAssignmentResource{}
+}
+
+func (r *proxyRoleAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy_role_assignment"
+}
+
+func (r *proxyRoleAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Pins an HTTP or RPC proxy to a role by setting @role on //sys/http_proxies/<address> or //sys/rpc_proxies/<address>.
+The role the proxy had before is remembered and restored when the resource is destroyed.
+
+More information:
+https://ytsaurus.tech/docs/en/admin-guide/proxy-roles`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Assignment id in the form <proxy_kind>/<address>.",
+			},
+			"proxy_kind": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("http", "rpc"),
+				},
+				Description: "Proxy kind, 'http' or 'rpc'.",
+			},
+			"address": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Proxy address in the form <host>:<port>.",
+			},
+			"role": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Role name, for example ytsaurus_proxy_role.<name>.name.",
+			},
+			"previous_role": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The role the proxy had before the assignment, restored on destroy. Null if the proxy had no explicit role.",
+			},
+		},
+	}
+}
+
+func (r *proxyRoleAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *proxyRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProxyRoleAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := proxyPath(plan.ProxyKind.ValueString(), plan.Address.ValueString())
+	ok, err := r.client.NodeExists(ctx, p, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating proxy_role_assignment",
+			fmt.Sprintf(
+				"Could not check proxy %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error creating proxy_role_assignment",
+			fmt.Sprintf("Proxy %q is not registered", p.String()),
+		)
+		return
+	}
+
+	previousRole, err := r.readRole(ctx, p)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating proxy_role_assignment",
+			fmt.Sprintf(
+				"Could not read proxy %q role, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := r.client.SetNode(ctx, p.Attr("role"), plan.Role.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating proxy_role_assignment",
+			fmt.Sprintf(
+				"Could not set node %q to %q, unexpected error: %q",
+				p.Attr("role").String(),
+				plan.Role.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ProxyKind.ValueString() + idSeparator + plan.Address.ValueString())
+	plan.PreviousRole = previousRole
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *proxyRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProxyRoleAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := proxyPath(state.ProxyKind.ValueString(), state.Address.ValueString())
+	ok, err := r.client.NodeExists(ctx, p, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading proxy_role_assignment",
+			fmt.Sprintf(
+				"Could not check proxy %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	role, err := r.readRole(ctx, p)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading proxy_role_assignment",
+			fmt.Sprintf(
+				"Could not read proxy %q role, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if role.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Role = role
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *proxyRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProxyRoleAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state ProxyRoleAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := proxyPath(state.ProxyKind.ValueString(), state.Address.ValueString()).Attr("role")
+	if err := r.client.SetNode(ctx, p, plan.Role.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating proxy_role_assignment",
+			fmt.Sprintf(
+				"Could not set node %q to %q, unexpected error: %q",
+				p.String(),
+				plan.Role.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.PreviousRole = state.PreviousRole
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *proxyRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProxyRoleAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := proxyPath(state.ProxyKind.ValueString(), state.Address.ValueString())
+	ok, err := r.client.NodeExists(ctx, p, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting proxy_role_assignment",
+			fmt.Sprintf(
+				"Could not check proxy %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	if state.PreviousRole.IsNull() {
+		err = ytsaurus.RemoveIfExists(ctx, r.client, p.Attr("role"))
+	} else {
+		err = r.client.SetNode(ctx, p.Attr("role"), state.PreviousRole.ValueString(), nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting proxy_role_assignment",
+			fmt.Sprintf(
+				"Could not restore proxy %q role, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *proxyRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, idSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Error importing proxy_role_assignment",
+			fmt.Sprintf("Expected import id in the form <proxy_kind>/<address>, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("proxy_kind"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("previous_role"), types.StringNull())...)
+}
+
+func (r *proxyRoleAssignmentResource) readRole(ctx context.Context, p ypath.Path) (types.String, error) {
+	ok, err := r.client.NodeExists(ctx, p.Attr("role"), nil)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	if !ok {
+		return types.StringNull(), nil
+	}
+
+	var role string
+	if err := r.client.GetNode(ctx, p.Attr("role"), &role, nil); err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(role), nil
+}
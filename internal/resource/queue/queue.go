@@ -0,0 +1,845 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	"go.ytsaurus.tech/yt/go/yterrors"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const (
+	defaultTabletCellBundle = "default"
+	defaultQueueAgentStage  = "production"
+	defaultState            = yt.TabletMounted
+)
+
+type queueResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                     = &queueResource{}
+	_ resource.ResourceWithConfigure        = &queueResource{}
+	_ resource.ResourceWithImportState      = &queueResource{}
+	_ resource.ResourceWithConfigValidators = &queueResource{}
+)
+
+type QueueAutoTrimConfigModel struct {
+	Enable                   types.Bool  `tfsdk:"enable"`
+	RetainedRows             types.Int64 `tfsdk:"retained_rows"`
+	RetainedLifetimeDuration types.Int64 `tfsdk:"retained_lifetime_duration"`
+}
+
+type QueueStaticExportConfigModel struct {
+	ExportDirectory            types.String `tfsdk:"export_directory"`
+	ExportPeriod               types.Int64  `tfsdk:"export_period"`
+	OutputTableNamePattern     types.String `tfsdk:"output_table_name_pattern"`
+	UseUpperBoundForTableNames types.Bool   `tfsdk:"use_upper_bound_for_table_names"`
+}
+
+type QueueModel struct {
+	ID                 types.String                            `tfsdk:"id"`
+	Path               types.String                            `tfsdk:"path"`
+	Schema             *tableschema.TableSchemaModel           `tfsdk:"schema"`
+	TabletCellBundle   types.String                            `tfsdk:"tablet_cell_bundle"`
+	TabletCount        types.Int64                             `tfsdk:"tablet_count"`
+	State              types.String                            `tfsdk:"state"`
+	AutoTrimConfig     *QueueAutoTrimConfigModel               `tfsdk:"auto_trim_config"`
+	StaticExportConfig map[string]QueueStaticExportConfigModel `tfsdk:"static_export_config"`
+	QueueAgentStage    types.String                            `tfsdk:"queue_agent_stage"`
+	Account            types.String                            `tfsdk:"account"`
+	InheritACL         types.Bool                              `tfsdk:"inherit_acl"`
+	ACL                acl.ACLModel                            `tfsdk:"acl"`
+	Status             types.Object                            `tfsdk:"status"`
+}
+
+var queuePartitionAttrTypes = map[string]attr.Type{
+	"lower_row_index":     types.Int64Type,
+	"upper_row_index":     types.Int64Type,
+	"available_row_count": types.Int64Type,
+	"commit_idle_time":    types.Int64Type,
+}
+
+var queueStatusAttrTypes = map[string]attr.Type{
+	"partition_count": types.Int64Type,
+	"partitions":      types.ListType{ElemType: types.ObjectType{AttrTypes: queuePartitionAttrTypes}},
+}
+
+func toQueueAutoTrimConfigModel(c *ytsaurus.QueueAutoTrimConfig) *QueueAutoTrimConfigModel {
+	if c == nil {
+		return nil
+	}
+
+	config := QueueAutoTrimConfigModel{
+		Enable:                   types.BoolValue(c.Enable),
+		RetainedRows:             types.Int64Null(),
+		RetainedLifetimeDuration: types.Int64Null(),
+	}
+	if c.RetainedRows != nil {
+		config.RetainedRows = types.Int64Value(*c.RetainedRows)
+	}
+	if c.RetainedLifetimeDuration != nil {
+		config.RetainedLifetimeDuration = types.Int64Value(*c.RetainedLifetimeDuration)
+	}
+	return &config
+}
+
+func toYTsaurusQueueAutoTrimConfig(c *QueueAutoTrimConfigModel) *ytsaurus.QueueAutoTrimConfig {
+	if c == nil {
+		return nil
+	}
+
+	config := ytsaurus.QueueAutoTrimConfig{
+		Enable: c.Enable.ValueBool(),
+	}
+	if !c.RetainedRows.IsNull() {
+		retainedRows := c.RetainedRows.ValueInt64()
+		config.RetainedRows = &retainedRows
+	}
+	if !c.RetainedLifetimeDuration.IsNull() {
+		retainedLifetimeDuration := c.RetainedLifetimeDuration.ValueInt64()
+		config.RetainedLifetimeDuration = &retainedLifetimeDuration
+	}
+	return &config
+}
+
+func toQueueModel(q ytsaurus.Queue) QueueModel {
+	queue := QueueModel{
+		ID:               types.StringValue(q.ID),
+		Path:             types.StringValue(q.Path),
+		Schema:           tableschema.ToTableSchemaModel(q.Schema),
+		TabletCellBundle: types.StringValue(q.TabletCellBundle),
+		TabletCount:      types.Int64Value(q.TabletCount),
+		State:            types.StringValue(q.TabletState),
+		AutoTrimConfig:   toQueueAutoTrimConfigModel(q.AutoTrimConfig),
+		QueueAgentStage:  types.StringValue(q.QueueAgentStage),
+		Account:          types.StringValue(q.Account),
+		InheritACL:       types.BoolValue(q.InheritACL),
+		ACL:              acl.ToACLModel(q.ACL),
+		Status:           types.ObjectNull(queueStatusAttrTypes),
+	}
+
+	if len(q.StaticExportConfig) > 0 {
+		queue.StaticExportConfig = make(map[string]QueueStaticExportConfigModel)
+		for k, v := range q.StaticExportConfig {
+			config := QueueStaticExportConfigModel{
+				ExportDirectory:            types.StringValue(v.ExportDirectory),
+				ExportPeriod:               types.Int64Value(v.ExportPeriod),
+				OutputTableNamePattern:     types.StringNull(),
+				UseUpperBoundForTableNames: types.BoolValue(v.UseUpperBoundForTableNames),
+			}
+			if v.OutputTableNamePattern != nil {
+				config.OutputTableNamePattern = types.StringValue(*v.OutputTableNamePattern)
+			}
+			queue.StaticExportConfig[k] = config
+		}
+	}
+
+	return queue
+}
+
+func toYTsaurusQueue(q QueueModel) (ytsaurus.Queue, diag.Diagnostics) {
+	acl, diags := acl.ToYTsaurusACL(q.ACL)
+	queue := ytsaurus.Queue{
+		ID:               q.ID.ValueString(),
+		Path:             q.Path.ValueString(),
+		Schema:           tableschema.ToYTsaurusSchema(q.Schema),
+		TabletCellBundle: q.TabletCellBundle.ValueString(),
+		TabletCount:      q.TabletCount.ValueInt64(),
+		TabletState:      q.State.ValueString(),
+		AutoTrimConfig:   toYTsaurusQueueAutoTrimConfig(q.AutoTrimConfig),
+		QueueAgentStage:  q.QueueAgentStage.ValueString(),
+		Account:          q.Account.ValueString(),
+		InheritACL:       q.InheritACL.ValueBool(),
+		ACL:              acl,
+	}
+
+	if len(q.StaticExportConfig) > 0 {
+		queue.StaticExportConfig = make(map[string]ytsaurus.QueueStaticExportConfig)
+		for k, v := range q.StaticExportConfig {
+			config := ytsaurus.QueueStaticExportConfig{
+				ExportDirectory:            v.ExportDirectory.ValueString(),
+				ExportPeriod:               v.ExportPeriod.ValueInt64(),
+				UseUpperBoundForTableNames: v.UseUpperBoundForTableNames.ValueBool(),
+			}
+			if !v.OutputTableNamePattern.IsNull() {
+				pattern := v.OutputTableNamePattern.ValueString()
+				config.OutputTableNamePattern = &pattern
+			}
+			queue.StaticExportConfig[k] = config
+		}
+	}
+
+	return queue, diags
+}
+
+func toQueueStatusObject(status *ytsaurus.QueueStatus, partitions []ytsaurus.QueuePartition) (types.Object, diag.Diagnostics) {
+	if status == nil {
+		return types.ObjectNull(queueStatusAttrTypes), nil
+	}
+
+	var diags diag.Diagnostics
+	partitionValues := []attr.Value{}
+	for _, p := range partitions {
+		partition, partitionDiags := types.ObjectValue(queuePartitionAttrTypes, map[string]attr.Value{
+			"lower_row_index":     types.Int64Value(p.LowerRowIndex),
+			"upper_row_index":     types.Int64Value(p.UpperRowIndex),
+			"available_row_count": types.Int64Value(p.AvailableRowCount),
+			"commit_idle_time":    types.Int64Value(p.CommitIdleTime),
+		})
+		diags.Append(partitionDiags...)
+		partitionValues = append(partitionValues, partition)
+	}
+
+	partitionList, listDiags := types.ListValue(types.ObjectType{AttrTypes: queuePartitionAttrTypes}, partitionValues)
+	diags.Append(listDiags...)
+
+	statusObject, objectDiags := types.ObjectValue(queueStatusAttrTypes, map[string]attr.Value{
+		"partition_count": types.Int64Value(status.PartitionCount),
+		"partitions":      partitionList,
+	})
+	diags.Append(objectDiags...)
+	return statusObject, diags
+}
+
+func NewQueueResource() resource.Resource {
+	return &queueResource{}
+}
+
+func (r *queueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue"
+}
+
+func (r *queueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A queue is an ordered dynamic table served by the queue agent.
+Consumers are registered with the ytsaurus_queue_consumer_registration resource.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/dynamic-tables/queues`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Queue table absolute path.",
+			},
+			"schema": schema.SingleNestedAttribute{
+				Required:    true,
+				Attributes:  tableschema.TableSchemaAttributes,
+				Description: "Queue table schema, columns must not have a sort_order. Changing the schema unmounts the queue.",
+			},
+			"tablet_cell_bundle": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultTabletCellBundle),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "A tablet_cell_bundle to serve the queue's tablets. Changing the bundle unmounts the queue.",
+			},
+			"tablet_count": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Number of tablets, i.e. queue partitions. Changing the number of tablets unmounts and reshards the queue.",
+			},
+			"state": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultState),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						yt.TabletMounted,
+						yt.TabletUnmounted,
+						yt.TabletFrozen,
+					),
+				},
+				Description: "The desired tablet state of the queue, can be 'mounted', 'unmounted' or 'frozen'.",
+			},
+			"auto_trim_config": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"enable": schema.BoolAttribute{
+						Required:    true,
+						Description: "Trim rows which are read by all vital consumers.",
+					},
+					"retained_rows": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "Number of rows kept in every partition regardless of consumers.",
+					},
+					"retained_lifetime_duration": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "Rows younger than the duration in milliseconds are not trimmed.",
+					},
+				},
+				Description: "Automatic trimming of the queue, the queue is remounted on change.",
+			},
+			"static_export_config": schema.MapNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"export_directory": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+							Description: "Directory the static tables are exported to.",
+						},
+						"export_period": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1000),
+							},
+							Description: "Export period in milliseconds.",
+						},
+						"output_table_name_pattern": schema.StringAttribute{
+							Optional:    true,
+							Description: "Exported table name pattern, for example '%UNIX_TS-%PERIOD'.",
+						},
+						"use_upper_bound_for_table_names": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Use the upper bound of the export period in table names.",
+						},
+					},
+				},
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				Description: "Named configurations of the export of the queue into static tables, the queue is remounted on change.",
+			},
+			"queue_agent_stage": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultQueueAgentStage),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Queue agent stage serving the queue.",
+			},
+			"account": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Account used to keep track of the resources being used by the queue.",
+			},
+			"inherit_acl": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Enable or disable ACL inheritance from an object's parents.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+			"status": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"partition_count": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of queue partitions.",
+					},
+					"partitions": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"lower_row_index": schema.Int64Attribute{
+									Computed:    true,
+									Description: "Index of the first row which is not trimmed.",
+								},
+								"upper_row_index": schema.Int64Attribute{
+									Computed:    true,
+									Description: "Index of the row following the last written row.",
+								},
+								"available_row_count": schema.Int64Attribute{
+									Computed:    true,
+									Description: "Number of rows available for reading.",
+								},
+								"commit_idle_time": schema.Int64Attribute{
+									Computed:    true,
+									Description: "Milliseconds since the last row was committed, i.e. the partition write lag.",
+								},
+							},
+						},
+						Description: "Per-partition status.",
+					},
+				},
+				Description: "Queue status reported by the queue agent, null while the queue agent hasn't processed the queue.",
+			},
+		},
+	}
+}
+
+func (r *queueResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *queueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan QueueModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytQueue, diags := toYTsaurusQueue(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateNodeOptions{
+		Attributes: map[string]interface{}{
+			"dynamic":            true,
+			"schema":             ytQueue.Schema,
+			"tablet_cell_bundle": ytQueue.TabletCellBundle,
+			"queue_agent_stage":  ytQueue.QueueAgentStage,
+			"acl":                ytQueue.ACL,
+			"inherit_acl":        ytQueue.InheritACL,
+			"terraform_resource": true,
+		},
+	}
+	if ytQueue.AutoTrimConfig != nil {
+		createOptions.Attributes["auto_trim_config"] = ytQueue.AutoTrimConfig
+	}
+	if ytQueue.StaticExportConfig != nil {
+		createOptions.Attributes["static_export_config"] = ytQueue.StaticExportConfig
+	}
+	if ytQueue.Account != "" {
+		createOptions.Attributes["account"] = ytQueue.Account
+	}
+
+	p := ypath.Path(ytQueue.Path)
+	id, err := r.client.CreateNode(ctx, p, yt.NodeTable, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating queue",
+			fmt.Sprintf(
+				"Could not create queue %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	ytQueue.ID = id.String()
+
+	if ytQueue.TabletCount > 0 {
+		if err := r.reshard(ctx, ytQueue); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating queue",
+				fmt.Sprintf(
+					"Could not reshard queue %q, unexpected error: %q",
+					p.String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	if err := ytsaurus.SetTabletState(ctx, r.client, p, yt.TabletUnmounted, ytQueue.TabletState, false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating queue",
+			fmt.Sprintf(
+				"Could not set queue %q state to %q, unexpected error: %q",
+				p.String(),
+				ytQueue.TabletState,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(ytQueue.ID)
+	resp.Diagnostics.Append(r.setComputedAttributes(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *queueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state QueueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytQueue, err := r.read(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading queue",
+			fmt.Sprintf(
+				"Could not read queue with id %q, unexpected error: %q",
+				state.ID.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	newState := toQueueModel(ytQueue)
+	// The cluster may fill auto_trim_config with defaults when it is not configured.
+	if state.AutoTrimConfig == nil && ytQueue.AutoTrimConfig != nil &&
+		reflect.DeepEqual(*ytQueue.AutoTrimConfig, ytsaurus.QueueAutoTrimConfig{}) {
+		newState.AutoTrimConfig = nil
+	}
+
+	var diags diag.Diagnostics
+	newState.Status, diags = r.readStatus(ctx, state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *queueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan QueueModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state QueueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	ytQueuePlan, diags := toYTsaurusQueue(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytQueueCurrent, err := r.read(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating queue",
+			fmt.Sprintf(
+				"Could not read queue with id %q, unexpected error: %q",
+				state.ID.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	schemaChanged := !tableschema.IsEqual(plan.Schema, state.Schema)
+	bundleChanged := ytQueuePlan.TabletCellBundle != ytQueueCurrent.TabletCellBundle
+	reshardRequired := !plan.TabletCount.IsUnknown() && ytQueuePlan.TabletCount != ytQueueCurrent.TabletCount
+	remountRequired := !reflect.DeepEqual(ytQueuePlan.AutoTrimConfig, ytQueueCurrent.AutoTrimConfig) ||
+		!reflect.DeepEqual(ytQueuePlan.StaticExportConfig, ytQueueCurrent.StaticExportConfig)
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	currentState := ytQueueCurrent.TabletState
+	if (schemaChanged || bundleChanged || reshardRequired) && currentState != yt.TabletUnmounted {
+		if err := ytsaurus.SetTabletState(ctx, r.client, p, currentState, yt.TabletUnmounted, false); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating queue",
+				fmt.Sprintf(
+					"Could not unmount queue %q, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+		currentState = yt.TabletUnmounted
+	}
+
+	if schemaChanged {
+		alterOptions := &yt.AlterTableOptions{
+			Schema: ytQueuePlan.Schema,
+		}
+		if err := r.client.AlterTable(ctx, p, alterOptions); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating queue schema",
+				fmt.Sprintf(
+					"Could not alter queue %q, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	attributeUpdates := map[string]interface{}{
+		"queue_agent_stage": ytQueuePlan.QueueAgentStage,
+		"account":           ytQueuePlan.Account,
+		"acl":               ytQueuePlan.ACL,
+		"inherit_acl":       ytQueuePlan.InheritACL,
+	}
+	if bundleChanged {
+		attributeUpdates["tablet_cell_bundle"] = ytQueuePlan.TabletCellBundle
+	}
+	var attributeRemovals []string
+	if ytQueuePlan.AutoTrimConfig != nil {
+		attributeUpdates["auto_trim_config"] = ytQueuePlan.AutoTrimConfig
+	} else if ytQueueCurrent.AutoTrimConfig != nil {
+		attributeRemovals = append(attributeRemovals, "auto_trim_config")
+	}
+	if ytQueuePlan.StaticExportConfig != nil {
+		attributeUpdates["static_export_config"] = ytQueuePlan.StaticExportConfig
+	} else if ytQueueCurrent.StaticExportConfig != nil {
+		attributeRemovals = append(attributeRemovals, "static_export_config")
+	}
+
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating queue attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+	for _, k := range attributeRemovals {
+		if err := r.client.RemoveNode(ctx, p.Attr(k), nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating queue attributes",
+				fmt.Sprintf(
+					"Could not remove node %q, unexpected error: %q",
+					p.Attr(k).String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	if reshardRequired {
+		if err := r.reshard(ctx, ytQueuePlan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating queue",
+				fmt.Sprintf(
+					"Could not reshard queue %q, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	if err := ytsaurus.SetTabletState(ctx, r.client, p, currentState, ytQueuePlan.TabletState, remountRequired); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating queue",
+			fmt.Sprintf(
+				"Could not set queue %q state to %q, unexpected error: %q",
+				plan.Path.ValueString(),
+				ytQueuePlan.TabletState,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setComputedAttributes(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *queueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state QueueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	var currentState string
+	if err := r.client.GetNode(ctx, p.Attr("tablet_state"), &currentState, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting queue",
+			fmt.Sprintf(
+				"Could not read %q, unexpected error: %q",
+				p.Attr("tablet_state").String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := ytsaurus.SetTabletState(ctx, r.client, p, currentState, yt.TabletUnmounted, false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting queue",
+			fmt.Sprintf(
+				"Could not unmount queue %q, unexpected error: %q",
+				state.Path.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := r.client.RemoveNode(ctx, ypath.Path(state.Path.ValueString()), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting queue",
+			fmt.Sprintf(
+				"Could not delete queue %q, unexpected error: %q",
+				state.Path.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *queueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *queueResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		queueResourceConfigValidator{},
+	}
+}
+
+func (r *queueResource) read(ctx context.Context, objectID string) (ytsaurus.Queue, error) {
+	var ytQueue ytsaurus.Queue
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytQueue); err != nil {
+		return ytQueue, err
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID))
+	if err := r.client.GetNode(ctx, p.Attr("path"), &ytQueue.Path, nil); err != nil {
+		return ytQueue, err
+	}
+
+	return ytQueue, nil
+}
+
+// readStatus reads the queue agent's view of the queue. The queue agent may not have processed
+// the queue yet, in this case the status attributes are missing and the status is null.
+func (r *queueResource) readStatus(ctx context.Context, objectID string) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	p := ypath.Path(fmt.Sprintf("#%s", objectID))
+
+	var status ytsaurus.QueueStatus
+	var partitions []ytsaurus.QueuePartition
+	for k, v := range map[string]interface{}{
+		"queue_status":     &status,
+		"queue_partitions": &partitions,
+	} {
+		if err := r.client.GetNode(ctx, p.Attr(k), v, nil); err != nil {
+			if yterrors.ContainsResolveError(err) {
+				return types.ObjectNull(queueStatusAttrTypes), diags
+			}
+			diags.AddError(
+				"Error reading queue status",
+				fmt.Sprintf(
+					"Could not read %q, unexpected error: %q",
+					p.Attr(k).String(),
+					err.Error(),
+				),
+			)
+			return types.ObjectNull(queueStatusAttrTypes), diags
+		}
+	}
+
+	return toQueueStatusObject(&status, partitions)
+}
+
+func (r *queueResource) setComputedAttributes(ctx context.Context, m *QueueModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ytQueue, err := r.read(ctx, m.ID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error reading queue",
+			fmt.Sprintf(
+				"Could not read queue with id %q, unexpected error: %q",
+				m.ID.ValueString(),
+				err.Error(),
+			),
+		)
+		return diags
+	}
+
+	if m.TabletCount.IsUnknown() {
+		m.TabletCount = types.Int64Value(ytQueue.TabletCount)
+	}
+	if m.Account.IsUnknown() {
+		m.Account = types.StringValue(ytQueue.Account)
+	}
+
+	m.Status, diags = r.readStatus(ctx, m.ID.ValueString())
+	return diags
+}
+
+func (r *queueResource) reshard(ctx context.Context, q ytsaurus.Queue) error {
+	tabletCount := int(q.TabletCount)
+	reshardOptions := &yt.ReshardTableOptions{
+		TabletCount: &tabletCount,
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", q.ID))
+	return r.client.ReshardTable(ctx, p, reshardOptions)
+}
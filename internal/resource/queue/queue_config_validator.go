@@ -0,0 +1,53 @@
+package queue
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+)
+
+type queueResourceConfigValidator struct{}
+
+var _ resource.ConfigValidator = &queueResourceConfigValidator{}
+
+func (v queueResourceConfigValidator) Description(_ context.Context) string {
+	return ""
+}
+
+func (v queueResourceConfigValidator) MarkdownDescription(_ context.Context) string {
+	return ""
+}
+
+func (v queueResourceConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config QueueModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Schema == nil {
+		return
+	}
+
+	if tableschema.IsSorted(tableschema.ToYTsaurusSchema(config.Schema)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schema"),
+			"Queue configuration error",
+			"A queue must be an ordered table, schema columns can't have a sort_order",
+		)
+		return
+	}
+
+	if config.AutoTrimConfig != nil && !config.AutoTrimConfig.Enable.IsUnknown() && !config.AutoTrimConfig.Enable.ValueBool() &&
+		(!config.AutoTrimConfig.RetainedRows.IsNull() || !config.AutoTrimConfig.RetainedLifetimeDuration.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auto_trim_config"),
+			"Queue configuration error",
+			"retained_rows and retained_lifetime_duration take effect only when auto trimming is enabled",
+		)
+		return
+	}
+}
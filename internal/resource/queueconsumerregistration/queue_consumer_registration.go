@@ -0,0 +1,273 @@
+package queueconsumerregistration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const idSeparator = ";"
+
+type queueConsumerRegistrationResource struct {
+	client *ytsaurus.Client
+}
+
+type QueueConsumerRegistrationModel struct {
+	ID           types.String `tfsdk:"id"`
+	QueuePath    types.String `tfsdk:"queue_path"`
+	ConsumerPath types.String `tfsdk:"consumer_path"`
+	Vital        types.Bool   `tfsdk:"vital"`
+	Partitions   types.List   `tfsdk:"partitions"`
+}
+
+var (
+	_ resource.Resource                = &queueConsumerRegistrationResource{}
+	_ resource.ResourceWithConfigure   = &queueConsumerRegistrationResource{}
+	_ resource.ResourceWithImportState = &queueConsumerRegistrationResource{}
+)
+
+func NewQueueConsumerRegistrationResource() resource.Resource {
+	return &queueConsumerRegistrationResource{}
+}
+
+func toQueueConsumerRegistrationID(queuePath, consumerPath string) string {
+	return queuePath + idSeparator + consumerPath
+}
+
+func toRegisterParams(ctx context.Context, m QueueConsumerRegistrationModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	params := map[string]interface{}{
+		"queue_path":    m.QueuePath.ValueString(),
+		"consumer_path": m.ConsumerPath.ValueString(),
+		"vital":         m.Vital.ValueBool(),
+	}
+	if !m.Partitions.IsNull() {
+		var partitions []int64
+		diags = m.Partitions.ElementsAs(ctx, &partitions, false)
+		params["partitions"] = partitions
+	}
+	return params, diags
+}
+
+func (r *queueConsumerRegistrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue_consumer_registration"
+}
+
+func (r *queueConsumerRegistrationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*ytsaurus.Client)
+}
+
+func (r *queueConsumerRegistrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Registration of a consumer table for a queue table. A consumer must be registered before it can read the queue.
+
+Import id has the form 'queue_path;consumer_path'.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/dynamic-tables/queues`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Registration identifier in the form 'queue_path;consumer_path'.",
+			},
+			"queue_path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Path of the queue table, a cluster can be specified with the rich YPath syntax: '<cluster=name>//path'.",
+			},
+			"consumer_path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Path of the consumer table, a cluster can be specified with the rich YPath syntax: '<cluster=name>//path'.",
+			},
+			"vital": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Rows of the queue are not trimmed until they are read by a vital consumer.",
+			},
+			"partitions": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+				Description: "Queue partitions the consumer is allowed to read, all partitions if omitted.",
+			},
+		},
+	}
+}
+
+func (r *queueConsumerRegistrationResource) register(ctx context.Context, m QueueConsumerRegistrationModel) diag.Diagnostics {
+	params, diags := toRegisterParams(ctx, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.client.ExecuteCommand(ctx, "register_queue_consumer", params, nil); err != nil {
+		diags.AddError(
+			"Error registering queue consumer",
+			fmt.Sprintf(
+				"Could not register consumer %q for queue %q, unexpected error: %q",
+				m.ConsumerPath.ValueString(),
+				m.QueuePath.ValueString(),
+				err.Error(),
+			),
+		)
+	}
+	return diags
+}
+
+func (r *queueConsumerRegistrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan QueueConsumerRegistrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.register(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(toQueueConsumerRegistrationID(plan.QueuePath.ValueString(), plan.ConsumerPath.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *queueConsumerRegistrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state QueueConsumerRegistrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var registrations []ytsaurus.QueueConsumerRegistration
+	params := map[string]interface{}{
+		"queue_path":    state.QueuePath.ValueString(),
+		"consumer_path": state.ConsumerPath.ValueString(),
+	}
+	if err := r.client.ExecuteCommand(ctx, "list_queue_consumer_registrations", params, &registrations); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading queue consumer registration",
+			fmt.Sprintf(
+				"Could not list registrations of consumer %q for queue %q, unexpected error: %q",
+				state.ConsumerPath.ValueString(),
+				state.QueuePath.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if len(registrations) == 0 {
+		// The consumer was unregistered outside of Terraform, the plan will register it again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	registration := registrations[0]
+	state.Vital = types.BoolValue(registration.Vital)
+	if registration.Partitions != nil {
+		var partitions []attr.Value
+		for _, p := range *registration.Partitions {
+			partitions = append(partitions, types.Int64Value(p))
+		}
+		state.Partitions = types.ListValueMust(types.Int64Type, partitions)
+	} else {
+		state.Partitions = types.ListNull(types.Int64Type)
+	}
+	state.ID = types.StringValue(toQueueConsumerRegistrationID(state.QueuePath.ValueString(), state.ConsumerPath.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *queueConsumerRegistrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan QueueConsumerRegistrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Registering an already registered consumer updates the registration in place.
+	resp.Diagnostics.Append(r.register(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *queueConsumerRegistrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state QueueConsumerRegistrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{
+		"queue_path":    state.QueuePath.ValueString(),
+		"consumer_path": state.ConsumerPath.ValueString(),
+	}
+	if err := r.client.ExecuteCommand(ctx, "unregister_queue_consumer", params, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error unregistering queue consumer",
+			fmt.Sprintf(
+				"Could not unregister consumer %q for queue %q, unexpected error: %q",
+				state.ConsumerPath.ValueString(),
+				state.QueuePath.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *queueConsumerRegistrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, idSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Error importing queue consumer registration",
+			fmt.Sprintf("Expected import id in the form 'queue_path;consumer_path', got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("queue_path"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("consumer_path"), parts[1])...)
+}
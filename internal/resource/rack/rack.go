@@ -0,0 +1,229 @@
+package rack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const nodeTypeRack yt.NodeType = "rack"
+
+type rackResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                = &rackResource{}
+	_ resource.ResourceWithConfigure   = &rackResource{}
+	_ resource.ResourceWithImportState = &rackResource{}
+)
+
+type RackModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	DataCenter types.String `tfsdk:"data_center"`
+}
+
+func toRackModel(r ytsaurus.Rack) RackModel {
+	rack := RackModel{
+		ID:         types.StringValue(r.ID),
+		Name:       types.StringValue(r.Name),
+		DataCenter: types.StringNull(),
+	}
+	if r.DataCenter != "" {
+		rack.DataCenter = types.StringValue(r.DataCenter)
+	}
+	return rack
+}
+
+func NewRackResource() resource.Resource {
+	return &rackResource{}
+}
+
+func (r *rackResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rack"
+}
+
+func (r *rackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A rack groups cluster hosts, the replicator respects per rack replica limits of media (max_replicas_per_rack and others).
+
+More information:
+https://ytsaurus.tech/docs/en/admin-guide/cluster-topology`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "YTsaurus rack name.",
+			},
+			"data_center": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Name of the data center the rack belongs to.",
+			},
+		},
+	}
+}
+
+func (r *rackResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *rackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RackModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateObjectOptions{
+		Attributes: map[string]interface{}{
+			"name":               plan.Name.ValueString(),
+			"terraform_resource": true,
+		},
+	}
+	if !plan.DataCenter.IsNull() {
+		createOptions.Attributes["data_center"] = plan.DataCenter.ValueString()
+	}
+
+	id, err := r.client.CreateObject(ctx, nodeTypeRack, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating rack",
+			fmt.Sprintf(
+				"Could not create rack %q, unexpected error: %q",
+				plan.Name.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *rackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rack ytsaurus.Rack
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &rack); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading rack",
+			fmt.Sprintf(
+				"Could not read rack by id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := toRackModel(rack)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *rackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RackModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state RackModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	attributeUpdates := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	if !plan.DataCenter.IsNull() {
+		attributeUpdates["data_center"] = plan.DataCenter.ValueString()
+	}
+
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating rack attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	if plan.DataCenter.IsNull() {
+		attrPath := p.Attr("data_center")
+		if err := ytsaurus.RemoveIfExists(ctx, r.client, attrPath); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating rack attributes",
+				fmt.Sprintf(
+					"Could not remove attribute %q, unexpected error: %q",
+					attrPath.String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *rackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID))
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting rack",
+			fmt.Sprintf(
+				"Could not delete rack %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *rackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,551 @@
+package replicatedtable
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const (
+	defaultTabletCellBundle = "default"
+	defaultState            = yt.TabletMounted
+)
+
+type replicatedTableResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                     = &replicatedTableResource{}
+	_ resource.ResourceWithConfigure        = &replicatedTableResource{}
+	_ resource.ResourceWithImportState      = &replicatedTableResource{}
+	_ resource.ResourceWithModifyPlan       = &replicatedTableResource{}
+	_ resource.ResourceWithConfigValidators = &replicatedTableResource{}
+)
+
+type ReplicatedTableOptionsModel struct {
+	EnableReplicatedTableTracker types.Bool  `tfsdk:"enable_replicated_table_tracker"`
+	MinSyncReplicaCount          types.Int64 `tfsdk:"min_sync_replica_count"`
+	MaxSyncReplicaCount          types.Int64 `tfsdk:"max_sync_replica_count"`
+}
+
+type ReplicatedTableModel struct {
+	ID                     types.String                  `tfsdk:"id"`
+	Path                   types.String                  `tfsdk:"path"`
+	Schema                 *tableschema.TableSchemaModel `tfsdk:"schema"`
+	TabletCellBundle       types.String                  `tfsdk:"tablet_cell_bundle"`
+	State                  types.String                  `tfsdk:"state"`
+	ReplicatedTableOptions *ReplicatedTableOptionsModel  `tfsdk:"replicated_table_options"`
+	Account                types.String                  `tfsdk:"account"`
+	InheritACL             types.Bool                    `tfsdk:"inherit_acl"`
+	ACL                    acl.ACLModel                  `tfsdk:"acl"`
+}
+
+func toReplicatedTableOptionsModel(o *ytsaurus.ReplicatedTableOptions) *ReplicatedTableOptionsModel {
+	if o != nil {
+		return &ReplicatedTableOptionsModel{
+			EnableReplicatedTableTracker: types.BoolPointerValue(o.EnableReplicatedTableTracker),
+			MinSyncReplicaCount:          types.Int64PointerValue(o.MinSyncReplicaCount),
+			MaxSyncReplicaCount:          types.Int64PointerValue(o.MaxSyncReplicaCount),
+		}
+	} else {
+		return nil
+	}
+}
+
+func toYTsaurusReplicatedTableOptions(o *ReplicatedTableOptionsModel) *ytsaurus.ReplicatedTableOptions {
+	if o != nil {
+		return &ytsaurus.ReplicatedTableOptions{
+			EnableReplicatedTableTracker: o.EnableReplicatedTableTracker.ValueBoolPointer(),
+			MinSyncReplicaCount:          o.MinSyncReplicaCount.ValueInt64Pointer(),
+			MaxSyncReplicaCount:          o.MaxSyncReplicaCount.ValueInt64Pointer(),
+		}
+	} else {
+		return nil
+	}
+}
+
+func toReplicatedTableModel(t ytsaurus.ReplicatedTable) ReplicatedTableModel {
+	return ReplicatedTableModel{
+		ID:                     types.StringValue(t.ID),
+		Path:                   types.StringValue(t.Path),
+		Schema:                 tableschema.ToTableSchemaModel(t.Schema),
+		TabletCellBundle:       types.StringValue(t.TabletCellBundle),
+		State:                  types.StringValue(t.TabletState),
+		ReplicatedTableOptions: toReplicatedTableOptionsModel(t.ReplicatedTableOptions),
+		Account:                types.StringValue(t.Account),
+		InheritACL:             types.BoolValue(t.InheritACL),
+		ACL:                    acl.ToACLModel(t.ACL),
+	}
+}
+
+func toYTsaurusReplicatedTable(t ReplicatedTableModel) (ytsaurus.ReplicatedTable, diag.Diagnostics) {
+	acl, diags := acl.ToYTsaurusACL(t.ACL)
+	return ytsaurus.ReplicatedTable{
+		ID:                     t.ID.ValueString(),
+		Path:                   t.Path.ValueString(),
+		Schema:                 tableschema.ToYTsaurusSchema(t.Schema),
+		TabletCellBundle:       t.TabletCellBundle.ValueString(),
+		TabletState:            t.State.ValueString(),
+		ReplicatedTableOptions: toYTsaurusReplicatedTableOptions(t.ReplicatedTableOptions),
+		Account:                t.Account.ValueString(),
+		InheritACL:             t.InheritACL.ValueBool(),
+		ACL:                    acl,
+	}, diags
+}
+
+func NewReplicatedTableResource() resource.Resource {
+	return &replicatedTableResource{}
+}
+
+func (r *replicatedTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replicated_table"
+}
+
+func (r *replicatedTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A replicated table is a dynamic table that forwards writes to its replicas, the replicas are managed by ytsaurus_table_replica.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/dynamic-tables/replicated-dynamic-tables`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Table absolute path.",
+			},
+			"schema": schema.SingleNestedAttribute{
+				Required:    true,
+				Attributes:  tableschema.TableSchemaAttributes,
+				Description: "Table schema, must match the replicas' schema.",
+			},
+			"tablet_cell_bundle": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultTabletCellBundle),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "A tablet_cell_bundle to serve the table's tablets. Changing the bundle unmounts the table.",
+			},
+			"state": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultState),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						yt.TabletMounted,
+						yt.TabletUnmounted,
+					),
+				},
+				Description: "The desired tablet state of the table, can be 'mounted' or 'unmounted'.",
+			},
+			"replicated_table_options": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"enable_replicated_table_tracker": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Let the replicated table tracker switch replicas between sync and async modes automatically.",
+					},
+					"min_sync_replica_count": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "Minimum number of sync replicas kept by the replicated table tracker.",
+					},
+					"max_sync_replica_count": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						Description: "Maximum number of sync replicas kept by the replicated table tracker.",
+					},
+				},
+				Description: "Replicated table options. Options which are not set here are not managed.",
+			},
+			"account": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Account used to keep track of the resources being used by the table.",
+			},
+			"inherit_acl": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Enable or disable ACL inheritance from an object's parents.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+		},
+	}
+}
+
+func (r *replicatedTableResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+// ModifyPlan makes unique_keys default to true for sorted tables, sorted dynamic tables require unique keys.
+func (r *replicatedTableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config ReplicatedTableModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(tableschema.SetSortedUniqueKeysDefault(ctx, config.Schema, path.Root("schema"), &resp.Plan)...)
+}
+
+func (r *replicatedTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ReplicatedTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytTable, diags := toYTsaurusReplicatedTable(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateNodeOptions{
+		Attributes: map[string]interface{}{
+			"schema":             ytTable.Schema,
+			"tablet_cell_bundle": ytTable.TabletCellBundle,
+			"acl":                ytTable.ACL,
+			"inherit_acl":        ytTable.InheritACL,
+			"terraform_resource": true,
+		},
+	}
+	if ytTable.ReplicatedTableOptions != nil {
+		createOptions.Attributes["replicated_table_options"] = ytTable.ReplicatedTableOptions
+	}
+	if ytTable.Account != "" {
+		createOptions.Attributes["account"] = ytTable.Account
+	}
+
+	p := ypath.Path(ytTable.Path)
+	id, err := r.client.CreateNode(ctx, p, yt.NodeReplicatedTable, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating replicated_table",
+			fmt.Sprintf(
+				"Could not create replicated_table %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := ytsaurus.SetTabletState(ctx, r.client, p, yt.TabletUnmounted, ytTable.TabletState, false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating replicated_table",
+			fmt.Sprintf(
+				"Could not set replicated_table %q state to %q, unexpected error: %q",
+				p.String(),
+				ytTable.TabletState,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if ytTable.Account == "" {
+		if err := r.client.GetNode(ctx, p.Attr("account"), &ytTable.Account, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating replicated_table",
+				fmt.Sprintf(
+					"Could not read 'account' attribute, unexpected error: %q",
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(id.String())
+	plan.Account = types.StringValue(ytTable.Account)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *replicatedTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ReplicatedTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectID := state.ID.ValueString()
+	var ytTable ytsaurus.ReplicatedTable
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytTable); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading replicated_table",
+			fmt.Sprintf(
+				"Could not read replicated_table with id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID)).Attr("path")
+	if err := r.client.GetNode(ctx, p, &ytTable.Path, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading replicated_table @path attribute",
+			fmt.Sprintf(
+				"Could not read replicated_table %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	newState := toReplicatedTableModel(ytTable)
+
+	// Only the options declared in the configuration are managed,
+	// the rest of @replicated_table_options is filled with cluster defaults.
+	if state.ReplicatedTableOptions == nil {
+		newState.ReplicatedTableOptions = nil
+	} else if newState.ReplicatedTableOptions != nil {
+		if state.ReplicatedTableOptions.EnableReplicatedTableTracker.IsNull() {
+			newState.ReplicatedTableOptions.EnableReplicatedTableTracker = types.BoolNull()
+		}
+		if state.ReplicatedTableOptions.MinSyncReplicaCount.IsNull() {
+			newState.ReplicatedTableOptions.MinSyncReplicaCount = types.Int64Null()
+		}
+		if state.ReplicatedTableOptions.MaxSyncReplicaCount.IsNull() {
+			newState.ReplicatedTableOptions.MaxSyncReplicaCount = types.Int64Null()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *replicatedTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ReplicatedTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state ReplicatedTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Path.Equal(state.Path) {
+		resp.Diagnostics.AddError(
+			"Error updating replicated_table attributes",
+			"Builtin attribute 'path' cannot be updated",
+		)
+		return
+	}
+
+	ytTablePlan, diags := toYTsaurusReplicatedTable(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+
+	var currentState string
+	if err := r.client.GetNode(ctx, p.Attr("tablet_state"), &currentState, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating replicated_table",
+			fmt.Sprintf(
+				"Could not read %q, unexpected error: %q",
+				p.Attr("tablet_state").String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	schemaChanged := !tableschema.IsEqual(plan.Schema, state.Schema)
+	bundleChanged := !plan.TabletCellBundle.Equal(state.TabletCellBundle)
+	if (schemaChanged || bundleChanged) && currentState != yt.TabletUnmounted {
+		if err := ytsaurus.SetTabletState(ctx, r.client, p, currentState, yt.TabletUnmounted, false); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating replicated_table",
+				fmt.Sprintf(
+					"Could not unmount replicated_table %q, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+		currentState = yt.TabletUnmounted
+	}
+
+	if schemaChanged {
+		alterOptions := &yt.AlterTableOptions{
+			Schema: ytTablePlan.Schema,
+		}
+		if err := r.client.AlterTable(ctx, p, alterOptions); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating replicated_table schema",
+				fmt.Sprintf(
+					"Could not alter replicated_table %q, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	attributeUpdates := map[string]interface{}{
+		"account":     ytTablePlan.Account,
+		"acl":         ytTablePlan.ACL,
+		"inherit_acl": ytTablePlan.InheritACL,
+	}
+	if bundleChanged {
+		attributeUpdates["tablet_cell_bundle"] = ytTablePlan.TabletCellBundle
+	}
+	if o := ytTablePlan.ReplicatedTableOptions; o != nil {
+		if o.EnableReplicatedTableTracker != nil {
+			attributeUpdates["replicated_table_options/enable_replicated_table_tracker"] = *o.EnableReplicatedTableTracker
+		}
+		if o.MinSyncReplicaCount != nil {
+			attributeUpdates["replicated_table_options/min_sync_replica_count"] = *o.MinSyncReplicaCount
+		}
+		if o.MaxSyncReplicaCount != nil {
+			attributeUpdates["replicated_table_options/max_sync_replica_count"] = *o.MaxSyncReplicaCount
+		}
+	}
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating replicated_table attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	if err := ytsaurus.SetTabletState(ctx, r.client, p, currentState, ytTablePlan.TabletState, false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating replicated_table",
+			fmt.Sprintf(
+				"Could not set replicated_table %q state to %q, unexpected error: %q",
+				plan.Path.ValueString(),
+				ytTablePlan.TabletState,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *replicatedTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ReplicatedTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	var currentState string
+	if err := r.client.GetNode(ctx, p.Attr("tablet_state"), &currentState, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting replicated_table",
+			fmt.Sprintf(
+				"Could not read %q, unexpected error: %q",
+				p.Attr("tablet_state").String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := ytsaurus.SetTabletState(ctx, r.client, p, currentState, yt.TabletUnmounted, false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting replicated_table",
+			fmt.Sprintf(
+				"Could not unmount replicated_table %q, unexpected error: %q",
+				state.Path.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := r.client.RemoveNode(ctx, ypath.Path(state.Path.ValueString()), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting replicated_table",
+			fmt.Sprintf(
+				"Could not delete replicated_table %q, unexpected error: %q",
+				state.Path.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *replicatedTableResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		replicatedTableResourceConfigValidator{},
+	}
+}
+
+func (r *replicatedTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,32 @@
+package replicatedtable
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+)
+
+type replicatedTableResourceConfigValidator struct{}
+
+var _ resource.ConfigValidator = &replicatedTableResourceConfigValidator{}
+
+func (v replicatedTableResourceConfigValidator) Description(_ context.Context) string {
+	return ""
+}
+
+func (v replicatedTableResourceConfigValidator) MarkdownDescription(_ context.Context) string {
+	return ""
+}
+
+func (v replicatedTableResourceConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ReplicatedTableModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(tableschema.ValidateSortedUniqueKeys(config.Schema, path.Root("schema"), "Replicated table configuration error")...)
+}
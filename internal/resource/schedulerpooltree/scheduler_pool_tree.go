@@ -0,0 +1,399 @@
+package schedulerpooltree
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type schedulerPoolTreeResource struct {
+	client yt.Client
+}
+
+type SchedulerPoolTreeConfigModel struct {
+	NodesFilter                     types.String `tfsdk:"nodes_filter"`
+	DefaultParentPool               types.String `tfsdk:"default_parent_pool"`
+	FairShareStarvationTimeout      types.Int64  `tfsdk:"fair_share_starvation_timeout"`
+	FairShareStarvationTimeoutLimit types.Int64  `tfsdk:"fair_share_starvation_timeout_limit"`
+	MainResource                    types.String `tfsdk:"main_resource"`
+	MaxEphemeralPoolsPerUser        types.Int64  `tfsdk:"max_ephemeral_pools_per_user"`
+}
+
+type SchedulerPoolTreeModel struct {
+	ID     types.String                  `tfsdk:"id"`
+	Name   types.String                  `tfsdk:"name"`
+	Config *SchedulerPoolTreeConfigModel `tfsdk:"config"`
+	ACL    acl.ACLModel                  `tfsdk:"acl"`
+}
+
+func toSchedulerPoolTreeConfigModel(c *ytsaurus.SchedulerPoolTreeConfig) *SchedulerPoolTreeConfigModel {
+	if c == nil || *c == (ytsaurus.SchedulerPoolTreeConfig{}) {
+		return nil
+	}
+	return &SchedulerPoolTreeConfigModel{
+		NodesFilter:                     types.StringPointerValue(c.NodesFilter),
+		DefaultParentPool:               types.StringPointerValue(c.DefaultParentPool),
+		FairShareStarvationTimeout:      types.Int64PointerValue(c.FairShareStarvationTimeout),
+		FairShareStarvationTimeoutLimit: types.Int64PointerValue(c.FairShareStarvationTimeoutLimit),
+		MainResource:                    types.StringPointerValue(c.MainResource),
+		MaxEphemeralPoolsPerUser:        types.Int64PointerValue(c.MaxEphemeralPoolsPerUser),
+	}
+}
+
+func toYTsaurusSchedulerPoolTreeConfig(c *SchedulerPoolTreeConfigModel) *ytsaurus.SchedulerPoolTreeConfig {
+	if c == nil {
+		return nil
+	}
+	return &ytsaurus.SchedulerPoolTreeConfig{
+		NodesFilter:                     c.NodesFilter.ValueStringPointer(),
+		DefaultParentPool:               c.DefaultParentPool.ValueStringPointer(),
+		FairShareStarvationTimeout:      c.FairShareStarvationTimeout.ValueInt64Pointer(),
+		FairShareStarvationTimeoutLimit: c.FairShareStarvationTimeoutLimit.ValueInt64Pointer(),
+		MainResource:                    c.MainResource.ValueStringPointer(),
+		MaxEphemeralPoolsPerUser:        c.MaxEphemeralPoolsPerUser.ValueInt64Pointer(),
+	}
+}
+
+func toSchedulerPoolTreeModel(t ytsaurus.SchedulerPoolTree) SchedulerPoolTreeModel {
+	return SchedulerPoolTreeModel{
+		ID:     types.StringValue(t.ID),
+		Name:   types.StringValue(t.Name),
+		Config: toSchedulerPoolTreeConfigModel(t.Config),
+		ACL:    acl.ToACLModel(t.ACL),
+	}
+}
+
+func toYTsaurusSchedulerPoolTree(t SchedulerPoolTreeModel) (ytsaurus.SchedulerPoolTree, diag.Diagnostics) {
+	acl, diags := acl.ToYTsaurusACL(t.ACL)
+	return ytsaurus.SchedulerPoolTree{
+		ID:     t.ID.ValueString(),
+		Name:   t.Name.ValueString(),
+		Config: toYTsaurusSchedulerPoolTreeConfig(t.Config),
+		ACL:    acl,
+	}, diags
+}
+
+func ytSchedulerPoolTreeConfigToMap(c *ytsaurus.SchedulerPoolTreeConfig) map[string]interface{} {
+	m := make(map[string]interface{})
+	if c != nil {
+		if c.NodesFilter != nil {
+			m["nodes_filter"] = *c.NodesFilter
+		}
+		if c.DefaultParentPool != nil {
+			m["default_parent_pool"] = *c.DefaultParentPool
+		}
+		if c.FairShareStarvationTimeout != nil {
+			m["fair_share_starvation_timeout"] = *c.FairShareStarvationTimeout
+		}
+		if c.FairShareStarvationTimeoutLimit != nil {
+			m["fair_share_starvation_timeout_limit"] = *c.FairShareStarvationTimeoutLimit
+		}
+		if c.MainResource != nil {
+			m["main_resource"] = *c.MainResource
+		}
+		if c.MaxEphemeralPoolsPerUser != nil {
+			m["max_ephemeral_pools_per_user"] = *c.MaxEphemeralPoolsPerUser
+		}
+	}
+	return m
+}
+
+var (
+	_ resource.Resource                = &schedulerPoolTreeResource{}
+	_ resource.ResourceWithConfigure   = &schedulerPoolTreeResource{}
+	_ resource.ResourceWithImportState = &schedulerPoolTreeResource{}
+)
+
+func NewSchedulerPoolTreeResource() resource.Resource {
+	return &schedulerPoolTreeResource{}
+}
+
+func (r *schedulerPoolTreeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduler_pool_tree"
+}
+
+func (r *schedulerPoolTreeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A pool tree is a set of scheduler pools sharing the resources of the exec nodes matching the tree's nodes_filter.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/data-processing/scheduler/scheduler-and-pools`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "YTsaurus pool tree name.",
+			},
+			"config": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"nodes_filter": schema.StringAttribute{
+						Optional:    true,
+						Description: "A boolean formula over node tags selecting the exec nodes of the tree, for example 'gpu & !dev'.",
+					},
+					"default_parent_pool": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						Description: "A pool to place ephemeral pools into.",
+					},
+					"fair_share_starvation_timeout": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "Time in milliseconds an operation stays below its fair share before preemption starts on its behalf.",
+					},
+					"fair_share_starvation_timeout_limit": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "Upper bound in milliseconds of the starvation timeout of the pools.",
+					},
+					"main_resource": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"cpu",
+								"memory",
+								"gpu",
+							),
+						},
+						Description: "The resource used for fair share calculations, can be 'cpu', 'memory' or 'gpu'.",
+					},
+					"max_ephemeral_pools_per_user": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "Maximum number of ephemeral pools a user can create in the tree.",
+					},
+				},
+				Description: "Pool tree config. Options which are not set here are removed from the tree config.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+		},
+	}
+}
+
+func (r *schedulerPoolTreeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *schedulerPoolTreeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SchedulerPoolTreeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytPoolTree, diags := toYTsaurusSchedulerPoolTree(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateObjectOptions{
+		Attributes: map[string]interface{}{
+			"name":               ytPoolTree.Name,
+			"config":             ytSchedulerPoolTreeConfigToMap(ytPoolTree.Config),
+			"acl":                ytPoolTree.ACL,
+			"terraform_resource": true,
+		},
+	}
+
+	id, err := r.client.CreateObject(ctx, yt.NodeSchedulerPoolTree, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating scheduler_pool_tree",
+			fmt.Sprintf(
+				"Could not create scheduler_pool_tree %q, unexpected error: %q",
+				ytPoolTree.Name,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *schedulerPoolTreeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ytPoolTree ytsaurus.SchedulerPoolTree
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytPoolTree); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading scheduler_pool_tree",
+			fmt.Sprintf(
+				"Could not read scheduler_pool_tree by id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := toSchedulerPoolTreeModel(ytPoolTree)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *schedulerPoolTreeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SchedulerPoolTreeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state SchedulerPoolTreeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytPoolTreePlan, diags := toYTsaurusSchedulerPoolTree(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytPoolTreeState, diags := toYTsaurusSchedulerPoolTree(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", ytPoolTreeState.ID))
+	attributeUpdates := map[string]interface{}{
+		"acl": ytPoolTreePlan.ACL,
+	}
+	configPlan := ytSchedulerPoolTreeConfigToMap(ytPoolTreePlan.Config)
+	for k, v := range configPlan {
+		attributeUpdates["config/"+k] = v
+	}
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating scheduler_pool_tree",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	for k := range ytSchedulerPoolTreeConfigToMap(ytPoolTreeState.Config) {
+		if _, ok := configPlan[k]; ok {
+			continue
+		}
+		if err := r.client.RemoveNode(ctx, p.Attr("config/"+k), nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating scheduler_pool_tree",
+				fmt.Sprintf(
+					"Could not remove %q, unexpected error: %q",
+					p.Attr("config/"+k).String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *schedulerPoolTreeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SchedulerPoolTreeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+
+	var pools []string
+	if err := r.client.ListNode(ctx, p, &pools, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting scheduler_pool_tree",
+			fmt.Sprintf(
+				"Could not list pools of scheduler_pool_tree %q, unexpected error: %q",
+				state.Name.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if len(pools) > 0 {
+		resp.Diagnostics.AddError(
+			"Error deleting scheduler_pool_tree",
+			fmt.Sprintf(
+				"scheduler_pool_tree %q still has pools, delete them first: %s",
+				state.Name.ValueString(),
+				strings.Join(pools, ", "),
+			),
+		)
+		return
+	}
+
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting scheduler_pool_tree",
+			fmt.Sprintf(
+				"Could not delete scheduler_pool_tree %q, unexpected error: %q",
+				state.Name.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *schedulerPoolTreeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,410 @@
+package table
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/resource/acl"
+	"terraform-provider-ytsaurus/internal/resource/tableschema"
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type tableResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                = &tableResource{}
+	_ resource.ResourceWithConfigure   = &tableResource{}
+	_ resource.ResourceWithImportState = &tableResource{}
+)
+
+type TableModel struct {
+	ID                types.String                  `tfsdk:"id"`
+	Path              types.String                  `tfsdk:"path"`
+	Schema            *tableschema.TableSchemaModel `tfsdk:"schema"`
+	CompressionCodec  types.String                  `tfsdk:"compression_codec"`
+	ErasureCodec      types.String                  `tfsdk:"erasure_codec"`
+	OptimizeFor       types.String                  `tfsdk:"optimize_for"`
+	PrimaryMedium     types.String                  `tfsdk:"primary_medium"`
+	ReplicationFactor types.Int64                   `tfsdk:"replication_factor"`
+	Account           types.String                  `tfsdk:"account"`
+	InheritACL        types.Bool                    `tfsdk:"inherit_acl"`
+	ACL               acl.ACLModel                  `tfsdk:"acl"`
+}
+
+func toTableModel(t ytsaurus.Table) TableModel {
+	return TableModel{
+		ID:                types.StringValue(t.ID),
+		Path:              types.StringValue(t.Path),
+		Schema:            tableschema.ToTableSchemaModel(t.Schema),
+		CompressionCodec:  types.StringValue(t.CompressionCodec),
+		ErasureCodec:      types.StringValue(t.ErasureCodec),
+		OptimizeFor:       types.StringValue(t.OptimizeFor),
+		PrimaryMedium:     types.StringValue(t.PrimaryMedium),
+		ReplicationFactor: types.Int64Value(t.ReplicationFactor),
+		Account:           types.StringValue(t.Account),
+		InheritACL:        types.BoolValue(t.InheritACL),
+		ACL:               acl.ToACLModel(t.ACL),
+	}
+}
+
+func toYTsaurusTable(t TableModel) (ytsaurus.Table, diag.Diagnostics) {
+	acl, diags := acl.ToYTsaurusACL(t.ACL)
+	return ytsaurus.Table{
+		Path:              t.Path.ValueString(),
+		Schema:            tableschema.ToYTsaurusSchema(t.Schema),
+		CompressionCodec:  t.CompressionCodec.ValueString(),
+		ErasureCodec:      t.ErasureCodec.ValueString(),
+		OptimizeFor:       t.OptimizeFor.ValueString(),
+		PrimaryMedium:     t.PrimaryMedium.ValueString(),
+		ReplicationFactor: t.ReplicationFactor.ValueInt64(),
+		Account:           t.Account.ValueString(),
+		InheritACL:        t.InheritACL.ValueBool(),
+		ACL:               acl,
+	}, diags
+}
+
+func NewTableResource() resource.Resource {
+	return &tableResource{}
+}
+
+func (r *tableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_table"
+}
+
+func (r *tableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Static tables are a universal way of storing data in YTsaurus.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/storage/static-tables`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Table absolute path.",
+			},
+			"schema": schema.SingleNestedAttribute{
+				Optional:    true,
+				Attributes:  tableschema.TableSchemaAttributes,
+				Description: "Table schema. More information: https://ytsaurus.tech/docs/en/user-guide/storage/static-schema.",
+			},
+			"compression_codec": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Compression codec for new table chunks, for example lz4 or zstd_3.",
+			},
+			"erasure_codec": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Erasure codec for new table chunks, for example none or lrc_12_2_2.",
+			},
+			"optimize_for": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"lookup",
+						"scan",
+					),
+				},
+				Description: "Chunk storage format, can be 'lookup' (row-oriented) or 'scan' (column-oriented).",
+			},
+			"primary_medium": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A medium to store table chunks.",
+			},
+			"replication_factor": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "How many replicas should be stored for table chunks.",
+			},
+			"account": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Account used to keep track of the resources being used by the table.",
+			},
+			"inherit_acl": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Enable or disable ACL inheritance from an object's parents.",
+			},
+			"acl": schema.ListNestedAttribute{
+				Optional:     true,
+				NestedObject: acl.ACLSchema,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "A list of ACE records. More information: https://ytsaurus.tech/docs/en/user-guide/storage/access-control.",
+			},
+		},
+	}
+}
+
+func (r *tableResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *tableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ytTable, diags := toYTsaurusTable(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateNodeOptions{
+		Attributes: map[string]interface{}{
+			"acl":                ytTable.ACL,
+			"inherit_acl":        ytTable.InheritACL,
+			"terraform_resource": true,
+		},
+	}
+	if ytTable.Schema != nil {
+		createOptions.Attributes["schema"] = ytTable.Schema
+	}
+	for k, v := range map[string]string{
+		"compression_codec": ytTable.CompressionCodec,
+		"erasure_codec":     ytTable.ErasureCodec,
+		"optimize_for":      ytTable.OptimizeFor,
+		"primary_medium":    ytTable.PrimaryMedium,
+		"account":           ytTable.Account,
+	} {
+		if v != "" {
+			createOptions.Attributes[k] = v
+		}
+	}
+	if ytTable.ReplicationFactor > 0 {
+		createOptions.Attributes["replication_factor"] = ytTable.ReplicationFactor
+	}
+
+	p := ypath.Path(ytTable.Path)
+	id, err := r.client.CreateNode(ctx, p, yt.NodeTable, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating table",
+			fmt.Sprintf(
+				"Could not create table %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	var created ytsaurus.Table
+	if err := ytsaurus.GetObjectByID(ctx, r.client, id.String(), &created); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating table",
+			fmt.Sprintf(
+				"Could not read table %q attributes, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = types.StringValue(id.String())
+	state.CompressionCodec = types.StringValue(created.CompressionCodec)
+	state.ErasureCodec = types.StringValue(created.ErasureCodec)
+	state.OptimizeFor = types.StringValue(created.OptimizeFor)
+	state.PrimaryMedium = types.StringValue(created.PrimaryMedium)
+	state.ReplicationFactor = types.Int64Value(created.ReplicationFactor)
+	state.Account = types.StringValue(created.Account)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *tableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var objectID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &objectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ytTable ytsaurus.Table
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &ytTable); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading table",
+			fmt.Sprintf(
+				"Could not read table with id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", objectID)).Attr("path")
+	if err := r.client.GetNode(ctx, p, &ytTable.Path, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading table @path attribute",
+			fmt.Sprintf(
+				"Could not read table %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state := toTableModel(ytTable)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *tableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state TableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Path.Equal(state.Path) {
+		resp.Diagnostics.AddError(
+			"Error updating table attributes",
+			"Builtin attribute 'path' cannot be updated",
+		)
+		return
+	}
+
+	ytTable, diags := toYTsaurusTable(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+
+	if ytTable.Schema != nil && !tableschema.IsEqual(plan.Schema, state.Schema) {
+		alterOptions := &yt.AlterTableOptions{
+			Schema: ytTable.Schema,
+		}
+		if err := r.client.AlterTable(ctx, p, alterOptions); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating table schema",
+				fmt.Sprintf(
+					"Could not alter table %q, unexpected error: %q",
+					plan.Path.ValueString(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	attributeUpdates := map[string]interface{}{
+		"compression_codec":  ytTable.CompressionCodec,
+		"erasure_codec":      ytTable.ErasureCodec,
+		"optimize_for":       ytTable.OptimizeFor,
+		"primary_medium":     ytTable.PrimaryMedium,
+		"replication_factor": ytTable.ReplicationFactor,
+		"account":            ytTable.Account,
+		"acl":                ytTable.ACL,
+		"inherit_acl":        ytTable.InheritACL,
+	}
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating table attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *tableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(state.Path.ValueString())
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting table",
+			fmt.Sprintf(
+				"Could not delete table %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *tableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
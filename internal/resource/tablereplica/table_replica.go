@@ -0,0 +1,291 @@
+package tablereplica
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+const (
+	replicaStateEnabled = "enabled"
+)
+
+type tableReplicaResource struct {
+	client yt.Client
+}
+
+var (
+	_ resource.Resource                = &tableReplicaResource{}
+	_ resource.ResourceWithConfigure   = &tableReplicaResource{}
+	_ resource.ResourceWithImportState = &tableReplicaResource{}
+)
+
+type TableReplicaModel struct {
+	ID                 types.String `tfsdk:"id"`
+	TablePath          types.String `tfsdk:"table_path"`
+	ClusterName        types.String `tfsdk:"cluster_name"`
+	ReplicaPath        types.String `tfsdk:"replica_path"`
+	Mode               types.String `tfsdk:"mode"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	PreserveTimestamps types.Bool   `tfsdk:"preserve_timestamps"`
+}
+
+func toTableReplicaModel(r ytsaurus.TableReplica) TableReplicaModel {
+	return TableReplicaModel{
+		ID:                 types.StringValue(r.ID),
+		TablePath:          types.StringValue(r.TablePath),
+		ClusterName:        types.StringValue(r.ClusterName),
+		ReplicaPath:        types.StringValue(r.ReplicaPath),
+		Mode:               types.StringValue(r.Mode),
+		Enabled:            types.BoolValue(r.State == replicaStateEnabled),
+		PreserveTimestamps: types.BoolValue(r.PreserveTimestamps),
+	}
+}
+
+func NewTableReplicaResource() resource.Resource {
+	return &tableReplicaResource{}
+}
+
+func (r *tableReplicaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_table_replica"
+}
+
+func (r *tableReplicaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+A table replica links a replicated table to a dynamic table on a replica cluster.
+
+More information:
+https://ytsaurus.tech/docs/en/user-guide/dynamic-tables/replicated-dynamic-tables`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "ObjectID in the YTsaurus cluster, can be found in an object's @id attribute.",
+			},
+			"table_path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Path of the replicated table.",
+			},
+			"cluster_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the replica cluster.",
+			},
+			"replica_path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Path of the replica table on the replica cluster.",
+			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(yt.AsyncMode)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(yt.SyncMode),
+						string(yt.AsyncMode),
+					),
+				},
+				Description: "Replication mode, can be 'sync' or 'async'.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Enable or disable replication to the replica.",
+			},
+			"preserve_timestamps": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Description: "Preserve commit timestamps of the replicated rows.",
+			},
+		},
+	}
+}
+
+func (r *tableReplicaResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(yt.Client)
+}
+
+func (r *tableReplicaResource) alterReplica(ctx context.Context, id yt.NodeID, m TableReplicaModel) error {
+	enabled := m.Enabled.ValueBool()
+	mode := yt.TableReplicaMode(m.Mode.ValueString())
+	alterOptions := &yt.AlterTableReplicaOptions{
+		Enabled: &enabled,
+		Mode:    &mode,
+	}
+	return r.client.AlterTableReplica(ctx, id, alterOptions)
+}
+
+func (r *tableReplicaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TableReplicaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOptions := &yt.CreateObjectOptions{
+		Attributes: map[string]interface{}{
+			"table_path":          plan.TablePath.ValueString(),
+			"cluster_name":        plan.ClusterName.ValueString(),
+			"replica_path":        plan.ReplicaPath.ValueString(),
+			"mode":                plan.Mode.ValueString(),
+			"preserve_timestamps": plan.PreserveTimestamps.ValueBool(),
+		},
+	}
+
+	id, err := r.client.CreateObject(ctx, yt.NodeTableReplica, createOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating table_replica",
+			fmt.Sprintf(
+				"Could not create table_replica of %q to %s:%s, unexpected error: %q",
+				plan.TablePath.ValueString(),
+				plan.ClusterName.ValueString(),
+				plan.ReplicaPath.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	plan.ID = types.StringValue(id.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	if plan.Enabled.ValueBool() {
+		if err := r.alterReplica(ctx, id, plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating table_replica",
+				fmt.Sprintf(
+					"Could not enable table_replica %q, unexpected error: %q",
+					id.String(),
+					err.Error(),
+				),
+			)
+			plan.Enabled = types.BoolValue(false)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
+}
+
+func (r *tableReplicaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TableReplicaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectID := state.ID.ValueString()
+	var replica ytsaurus.TableReplica
+	if err := ytsaurus.GetObjectByID(ctx, r.client, objectID, &replica); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading table_replica",
+			fmt.Sprintf(
+				"Could not read table_replica with id %q, unexpected error: %q",
+				objectID,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, toTableReplicaModel(replica))...)
+}
+
+func (r *tableReplicaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TableReplicaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state TableReplicaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id yt.NodeID
+	if err := id.UnmarshalText([]byte(state.ID.ValueString())); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating table_replica",
+			fmt.Sprintf(
+				"Could not parse table_replica id %q, unexpected error: %q",
+				state.ID.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if err := r.alterReplica(ctx, id, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating table_replica",
+			fmt.Sprintf(
+				"Could not alter table_replica %q, unexpected error: %q",
+				state.ID.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *tableReplicaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TableReplicaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting table_replica",
+			fmt.Sprintf(
+				"Could not delete table_replica %q, unexpected error: %q",
+				state.ID.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+func (r *tableReplicaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
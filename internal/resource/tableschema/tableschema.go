@@ -0,0 +1,209 @@
+package tableschema
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ytschema "go.ytsaurus.tech/yt/go/schema"
+)
+
+type ColumnModel struct {
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	SortOrder types.String `tfsdk:"sort_order"`
+	Required  types.Bool   `tfsdk:"required"`
+}
+
+type TableSchemaModel struct {
+	Strict     types.Bool    `tfsdk:"strict"`
+	UniqueKeys types.Bool    `tfsdk:"unique_keys"`
+	Columns    []ColumnModel `tfsdk:"columns"`
+}
+
+func toYTsaurusColumn(c ColumnModel) ytschema.Column {
+	return ytschema.Column{
+		Name:      c.Name.ValueString(),
+		Type:      ytschema.Type(c.Type.ValueString()),
+		SortOrder: ytschema.SortOrder(c.SortOrder.ValueString()),
+		Required:  c.Required.ValueBool(),
+	}
+}
+
+func toColumnModel(c ytschema.Column) ColumnModel {
+	column := ColumnModel{
+		Name:     types.StringValue(c.Name),
+		Type:     types.StringValue(string(c.Type)),
+		Required: types.BoolValue(c.Required),
+	}
+
+	if c.SortOrder != ytschema.SortNone {
+		column.SortOrder = types.StringValue(string(c.SortOrder))
+	} else {
+		column.SortOrder = types.StringNull()
+	}
+
+	return column
+}
+
+func ToYTsaurusSchema(s *TableSchemaModel) *ytschema.Schema {
+	if s == nil {
+		return nil
+	}
+
+	strict := s.Strict.ValueBool()
+	ytSchema := &ytschema.Schema{
+		Strict:     &strict,
+		UniqueKeys: s.UniqueKeys.ValueBool(),
+		Columns:    make([]ytschema.Column, 0, len(s.Columns)),
+	}
+	for _, c := range s.Columns {
+		ytSchema.Columns = append(ytSchema.Columns, toYTsaurusColumn(c))
+	}
+	return ytSchema
+}
+
+func ToTableSchemaModel(s *ytschema.Schema) *TableSchemaModel {
+	if s == nil {
+		return nil
+	}
+
+	strict := s.Strict == nil || *s.Strict
+	if !strict && len(s.Columns) == 0 {
+		return nil
+	}
+
+	tableSchema := &TableSchemaModel{
+		Strict:     types.BoolValue(strict),
+		UniqueKeys: types.BoolValue(s.UniqueKeys),
+	}
+	for _, c := range s.Columns {
+		tableSchema.Columns = append(tableSchema.Columns, toColumnModel(c))
+	}
+	return tableSchema
+}
+
+func IsEqual(a, b *TableSchemaModel) bool {
+	return reflect.DeepEqual(ToYTsaurusSchema(a), ToYTsaurusSchema(b))
+}
+
+func IsSorted(s *ytschema.Schema) bool {
+	if s == nil {
+		return false
+	}
+	for _, c := range s.Columns {
+		if c.SortOrder != ytschema.SortNone {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSortedUniqueKeysDefault makes unique_keys default to true in the plan when the configured schema is sorted,
+// sorted dynamic tables require unique keys.
+func SetSortedUniqueKeysDefault(ctx context.Context, config *TableSchemaModel, schemaPath path.Path, plan *tfsdk.Plan) diag.Diagnostics {
+	if config == nil || !config.UniqueKeys.IsNull() || !IsSorted(ToYTsaurusSchema(config)) {
+		return nil
+	}
+	return plan.SetAttribute(ctx, schemaPath.AtName("unique_keys"), true)
+}
+
+// ValidateSortedUniqueKeys rejects unique_keys explicitly set to false for a sorted schema of a dynamic table.
+func ValidateSortedUniqueKeys(config *TableSchemaModel, schemaPath path.Path, summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if config == nil || config.UniqueKeys.IsNull() || config.UniqueKeys.IsUnknown() ||
+		config.UniqueKeys.ValueBool() || !IsSorted(ToYTsaurusSchema(config)) {
+		return diags
+	}
+	diags.AddAttributeError(
+		schemaPath.AtName("unique_keys"),
+		summary,
+		"Sorted dynamic tables require unique_keys to be true",
+	)
+	return diags
+}
+
+var TableSchemaAttributes = map[string]schema.Attribute{
+	"strict": schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+		Description: "Strict schemas forbid columns which are not listed in the schema.",
+	},
+	"unique_keys": schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+		Description: "Whether key columns of the table must be unique. Requires at least one sorted column. Defaults to true for sorted dynamic and replicated tables.",
+	},
+	"columns": schema.ListNestedAttribute{
+		Required: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					Description: "Column name.",
+				},
+				"type": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							string(ytschema.TypeInt64),
+							string(ytschema.TypeInt32),
+							string(ytschema.TypeInt16),
+							string(ytschema.TypeInt8),
+							string(ytschema.TypeUint64),
+							string(ytschema.TypeUint32),
+							string(ytschema.TypeUint16),
+							string(ytschema.TypeUint8),
+							string(ytschema.TypeFloat32),
+							string(ytschema.TypeFloat64),
+							string(ytschema.TypeBytes),
+							string(ytschema.TypeString),
+							string(ytschema.TypeBoolean),
+							string(ytschema.TypeAny),
+							string(ytschema.TypeDate),
+							string(ytschema.TypeDatetime),
+							string(ytschema.TypeTimestamp),
+							string(ytschema.TypeInterval),
+						),
+					},
+					Description: "Column type, for example int64, uint64, double, boolean, string, utf8 or any.",
+				},
+				"sort_order": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							string(ytschema.SortAscending),
+							string(ytschema.SortDescending),
+						),
+					},
+					Description: "Sort order of a key column, can be 'ascending' or 'descending'. Sorted columns must go first.",
+				},
+				"required": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+					Description: "Forbid null values in the column.",
+				},
+			},
+		},
+		Description: "An ordered list of table columns.",
+	},
+}
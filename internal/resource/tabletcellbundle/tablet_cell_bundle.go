@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -69,22 +70,172 @@ func toTabletCellBundleOptionsModel(o *ytsaurus.TabletCellBundleOptions) *Tablet
 	}
 }
 
+type TabletCellBundleResourceQuotaModel struct {
+	CPU    types.Int64 `tfsdk:"cpu"`
+	Memory types.Int64 `tfsdk:"memory"`
+}
+
+func toTabletCellBundleResourceQuotaModel(q *ytsaurus.TabletCellBundleResourceQuota) *TabletCellBundleResourceQuotaModel {
+	if q == nil {
+		return nil
+	}
+	return &TabletCellBundleResourceQuotaModel{
+		CPU:    types.Int64Value(q.CPU),
+		Memory: types.Int64Value(q.Memory),
+	}
+}
+
+func toYTsaurusTabletCellBundleResourceQuota(q *TabletCellBundleResourceQuotaModel) *ytsaurus.TabletCellBundleResourceQuota {
+	if q == nil {
+		return nil
+	}
+	return &ytsaurus.TabletCellBundleResourceQuota{
+		CPU:    q.CPU.ValueInt64(),
+		Memory: q.Memory.ValueInt64(),
+	}
+}
+
+type TabletCellBundleInstanceResourcesModel struct {
+	VCPU   types.Int64  `tfsdk:"vcpu"`
+	Memory types.Int64  `tfsdk:"memory"`
+	Type   types.String `tfsdk:"type"`
+}
+
+type TabletCellBundleMemoryLimitsModel struct {
+	TabletStatic           types.Int64 `tfsdk:"tablet_static"`
+	TabletDynamic          types.Int64 `tfsdk:"tablet_dynamic"`
+	CompressedBlockCache   types.Int64 `tfsdk:"compressed_block_cache"`
+	UncompressedBlockCache types.Int64 `tfsdk:"uncompressed_block_cache"`
+	VersionedChunkMeta     types.Int64 `tfsdk:"versioned_chunk_meta"`
+	LookupRowCache         types.Int64 `tfsdk:"lookup_row_cache"`
+	Reserved               types.Int64 `tfsdk:"reserved"`
+}
+
+func (m TabletCellBundleMemoryLimitsModel) values() []types.Int64 {
+	return []types.Int64{
+		m.TabletStatic,
+		m.TabletDynamic,
+		m.CompressedBlockCache,
+		m.UncompressedBlockCache,
+		m.VersionedChunkMeta,
+		m.LookupRowCache,
+		m.Reserved,
+	}
+}
+
+type TabletCellBundleCPULimitsModel struct {
+	LookupThreadPoolSize types.Int64 `tfsdk:"lookup_thread_pool_size"`
+	QueryThreadPoolSize  types.Int64 `tfsdk:"query_thread_pool_size"`
+	WriteThreadPoolSize  types.Int64 `tfsdk:"write_thread_pool_size"`
+}
+
+type TabletCellBundleTargetConfigModel struct {
+	TabletNodeCount             types.Int64                             `tfsdk:"tablet_node_count"`
+	RPCProxyCount               types.Int64                             `tfsdk:"rpc_proxy_count"`
+	TabletNodeResourceGuarantee *TabletCellBundleInstanceResourcesModel `tfsdk:"tablet_node_resource_guarantee"`
+	MemoryLimits                *TabletCellBundleMemoryLimitsModel      `tfsdk:"memory_limits"`
+	CPULimits                   *TabletCellBundleCPULimitsModel         `tfsdk:"cpu_limits"`
+}
+
+func toTabletCellBundleTargetConfigModel(c *ytsaurus.TabletCellBundleTargetConfig) *TabletCellBundleTargetConfigModel {
+	if c == nil {
+		return nil
+	}
+
+	config := &TabletCellBundleTargetConfigModel{
+		TabletNodeCount: types.Int64PointerValue(c.TabletNodeCount),
+		RPCProxyCount:   types.Int64PointerValue(c.RPCProxyCount),
+	}
+	if g := c.TabletNodeResourceGuarantee; g != nil {
+		config.TabletNodeResourceGuarantee = &TabletCellBundleInstanceResourcesModel{
+			VCPU:   types.Int64PointerValue(g.VCPU),
+			Memory: types.Int64PointerValue(g.Memory),
+			Type:   types.StringNull(),
+		}
+		if g.Type != "" {
+			config.TabletNodeResourceGuarantee.Type = types.StringValue(g.Type)
+		}
+	}
+	if l := c.MemoryLimits; l != nil {
+		config.MemoryLimits = &TabletCellBundleMemoryLimitsModel{
+			TabletStatic:           types.Int64PointerValue(l.TabletStatic),
+			TabletDynamic:          types.Int64PointerValue(l.TabletDynamic),
+			CompressedBlockCache:   types.Int64PointerValue(l.CompressedBlockCache),
+			UncompressedBlockCache: types.Int64PointerValue(l.UncompressedBlockCache),
+			VersionedChunkMeta:     types.Int64PointerValue(l.VersionedChunkMeta),
+			LookupRowCache:         types.Int64PointerValue(l.LookupRowCache),
+			Reserved:               types.Int64PointerValue(l.Reserved),
+		}
+	}
+	if l := c.CPULimits; l != nil {
+		config.CPULimits = &TabletCellBundleCPULimitsModel{
+			LookupThreadPoolSize: types.Int64PointerValue(l.LookupThreadPoolSize),
+			QueryThreadPoolSize:  types.Int64PointerValue(l.QueryThreadPoolSize),
+			WriteThreadPoolSize:  types.Int64PointerValue(l.WriteThreadPoolSize),
+		}
+	}
+
+	return config
+}
+
+func toYTsaurusTabletCellBundleTargetConfig(c *TabletCellBundleTargetConfigModel) *ytsaurus.TabletCellBundleTargetConfig {
+	if c == nil {
+		return nil
+	}
+
+	config := &ytsaurus.TabletCellBundleTargetConfig{
+		TabletNodeCount: c.TabletNodeCount.ValueInt64Pointer(),
+		RPCProxyCount:   c.RPCProxyCount.ValueInt64Pointer(),
+	}
+	if g := c.TabletNodeResourceGuarantee; g != nil {
+		config.TabletNodeResourceGuarantee = &ytsaurus.TabletCellBundleInstanceResources{
+			VCPU:   g.VCPU.ValueInt64Pointer(),
+			Memory: g.Memory.ValueInt64Pointer(),
+			Type:   g.Type.ValueString(),
+		}
+	}
+	if l := c.MemoryLimits; l != nil {
+		config.MemoryLimits = &ytsaurus.TabletCellBundleMemoryLimits{
+			TabletStatic:           l.TabletStatic.ValueInt64Pointer(),
+			TabletDynamic:          l.TabletDynamic.ValueInt64Pointer(),
+			CompressedBlockCache:   l.CompressedBlockCache.ValueInt64Pointer(),
+			UncompressedBlockCache: l.UncompressedBlockCache.ValueInt64Pointer(),
+			VersionedChunkMeta:     l.VersionedChunkMeta.ValueInt64Pointer(),
+			LookupRowCache:         l.LookupRowCache.ValueInt64Pointer(),
+			Reserved:               l.Reserved.ValueInt64Pointer(),
+		}
+	}
+	if l := c.CPULimits; l != nil {
+		config.CPULimits = &ytsaurus.TabletCellBundleCPULimits{
+			LookupThreadPoolSize: l.LookupThreadPoolSize.ValueInt64Pointer(),
+			QueryThreadPoolSize:  l.QueryThreadPoolSize.ValueInt64Pointer(),
+			WriteThreadPoolSize:  l.WriteThreadPoolSize.ValueInt64Pointer(),
+		}
+	}
+
+	return config
+}
+
 type TabletCellBundleModel struct {
-	ID              types.String                  `tfsdk:"id"`
-	Name            types.String                  `tfsdk:"name"`
-	NodeTagFilter   types.String                  `tfsdk:"node_tag_filter"`
-	TabletCellCount types.Int64                   `tfsdk:"tablet_cell_count"`
-	ACL             acl.ACLModel                  `tfsdk:"acl"`
-	Options         *TabletCellBundleOptionsModel `tfsdk:"options"`
+	ID                           types.String                        `tfsdk:"id"`
+	Name                         types.String                        `tfsdk:"name"`
+	NodeTagFilter                types.String                        `tfsdk:"node_tag_filter"`
+	TabletCellCount              types.Int64                         `tfsdk:"tablet_cell_count"`
+	ACL                          acl.ACLModel                        `tfsdk:"acl"`
+	Options                      *TabletCellBundleOptionsModel       `tfsdk:"options"`
+	ResourceQuota                *TabletCellBundleResourceQuotaModel `tfsdk:"resource_quota"`
+	BundleControllerTargetConfig *TabletCellBundleTargetConfigModel  `tfsdk:"bundle_controller_target_config"`
 }
 
 func toTabletCellBundleModel(b ytsaurus.TabletCellBundle) TabletCellBundleModel {
 	bundle := TabletCellBundleModel{
-		ID:              types.StringValue(b.ID),
-		Name:            types.StringValue(b.Name),
-		TabletCellCount: types.Int64Value(b.TabletCellCount),
-		ACL:             acl.ToACLModel(b.ACL),
-		Options:         toTabletCellBundleOptionsModel(b.Options),
+		ID:                           types.StringValue(b.ID),
+		Name:                         types.StringValue(b.Name),
+		TabletCellCount:              types.Int64Value(b.TabletCellCount),
+		ACL:                          acl.ToACLModel(b.ACL),
+		Options:                      toTabletCellBundleOptionsModel(b.Options),
+		ResourceQuota:                toTabletCellBundleResourceQuotaModel(b.ResourceQuota),
+		BundleControllerTargetConfig: toTabletCellBundleTargetConfigModel(b.BundleControllerTargetConfig),
 	}
 
 	if len(b.NodeTagFilter) > 0 {
@@ -122,13 +273,17 @@ func toYTsaurusTabletCellBundle(b TabletCellBundleModel) (ytsaurus.TabletCellBun
 		TabletCellCount: b.TabletCellCount.ValueInt64(),
 		ACL:             acl,
 		Options:         toYTsaurusTabletCellBundleOptions(b.Options),
+
+		ResourceQuota:                toYTsaurusTabletCellBundleResourceQuota(b.ResourceQuota),
+		BundleControllerTargetConfig: toYTsaurusTabletCellBundleTargetConfig(b.BundleControllerTargetConfig),
 	}, diags
 }
 
 var (
-	_ resource.Resource                = &tabletCellBundleResource{}
-	_ resource.ResourceWithConfigure   = &tabletCellBundleResource{}
-	_ resource.ResourceWithImportState = &tabletCellBundleResource{}
+	_ resource.Resource                     = &tabletCellBundleResource{}
+	_ resource.ResourceWithConfigure        = &tabletCellBundleResource{}
+	_ resource.ResourceWithImportState      = &tabletCellBundleResource{}
+	_ resource.ResourceWithConfigValidators = &tabletCellBundleResource{}
 )
 
 func NewTabletCellBundleResource() resource.Resource {
@@ -244,10 +399,127 @@ https://ytsaurus.tech/docs/en/user-guide/dynamic-tables/concepts`,
 					},
 				},
 			},
+			"resource_quota": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Resources the bundle is allowed to use. The quota is left as is when the attribute is removed from the configuration.",
+				Attributes: map[string]schema.Attribute{
+					"cpu": schema.Int64Attribute{
+						Required: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "CPU quota of the bundle.",
+					},
+					"memory": schema.Int64Attribute{
+						Required: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "Memory quota of the bundle in bytes.",
+					},
+				},
+			},
+			"bundle_controller_target_config": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Target instance sizing of the bundle used by the bundle controller.",
+				Attributes: map[string]schema.Attribute{
+					"tablet_node_count": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "Number of tablet nodes allocated to the bundle.",
+					},
+					"rpc_proxy_count": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "Number of RPC proxies allocated to the bundle.",
+					},
+					"tablet_node_resource_guarantee": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Resources of a single tablet node.",
+						Attributes: map[string]schema.Attribute{
+							"vcpu": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+								Description: "Node vCPU in millicores.",
+							},
+							"memory": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+								Description: "Node memory in bytes.",
+							},
+							"type": schema.StringAttribute{
+								Optional: true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
+								},
+								Description: "Instance type name.",
+							},
+						},
+					},
+					"memory_limits": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Memory distribution of a tablet node in bytes, the sum must fit into tablet_node_resource_guarantee.memory.",
+						Attributes: map[string]schema.Attribute{
+							"tablet_static":            memoryLimitSchema("Memory for in-memory tables."),
+							"tablet_dynamic":           memoryLimitSchema("Memory for dynamic stores."),
+							"compressed_block_cache":   memoryLimitSchema("Compressed block cache size."),
+							"uncompressed_block_cache": memoryLimitSchema("Uncompressed block cache size."),
+							"versioned_chunk_meta":     memoryLimitSchema("Versioned chunk meta cache size."),
+							"lookup_row_cache":         memoryLimitSchema("Lookup row cache size."),
+							"reserved":                 memoryLimitSchema("Memory reserved for other needs."),
+						},
+					},
+					"cpu_limits": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Thread pool sizes of a tablet node.",
+						Attributes: map[string]schema.Attribute{
+							"lookup_thread_pool_size": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+								Description: "Lookup thread pool size.",
+							},
+							"query_thread_pool_size": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+								Description: "Query thread pool size.",
+							},
+							"write_thread_pool_size": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+								Description: "Write thread pool size.",
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func memoryLimitSchema(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		Description: description,
+	}
+}
+
 func (r *tabletCellBundleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -280,6 +552,12 @@ func (r *tabletCellBundleResource) Create(ctx context.Context, req resource.Crea
 	if len(ytTabletCellBundle.NodeTagFilter) > 0 {
 		createOptions.Attributes["node_tag_filter"] = ytTabletCellBundle.NodeTagFilter
 	}
+	if ytTabletCellBundle.ResourceQuota != nil {
+		createOptions.Attributes["resource_quota"] = ytTabletCellBundle.ResourceQuota
+	}
+	if ytTabletCellBundle.BundleControllerTargetConfig != nil {
+		createOptions.Attributes["bundle_controller_target_config"] = ytTabletCellBundle.BundleControllerTargetConfig
+	}
 
 	id, err := r.client.CreateObject(ctx, yt.NodeTabletCellBundle, createOptions)
 	if err != nil {
@@ -362,6 +640,17 @@ func (r *tabletCellBundleResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	state := toTabletCellBundleModel(ytTabletCellBundle)
+
+	// Reset quota and target config to nil if they weren't configured in .tf file
+	var currentState TabletCellBundleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentState)...)
+	if currentState.ResourceQuota == nil {
+		state.ResourceQuota = nil
+	}
+	if currentState.BundleControllerTargetConfig == nil {
+		state.BundleControllerTargetConfig = nil
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -393,6 +682,12 @@ func (r *tabletCellBundleResource) Update(ctx context.Context, req resource.Upda
 		"node_tag_filter": ytTabletCellBundlePlan.NodeTagFilter,
 		"acl":             ytTabletCellBundlePlan.ACL,
 	}
+	if ytTabletCellBundlePlan.ResourceQuota != nil {
+		attributeUpdates["resource_quota"] = ytTabletCellBundlePlan.ResourceQuota
+	}
+	if ytTabletCellBundlePlan.BundleControllerTargetConfig != nil {
+		attributeUpdates["bundle_controller_target_config"] = ytTabletCellBundlePlan.BundleControllerTargetConfig
+	}
 
 	p := ypath.Path(fmt.Sprintf("#%s", ytTabletCellBundleState.ID))
 	for k, v := range attributeUpdates {
@@ -410,6 +705,21 @@ func (r *tabletCellBundleResource) Update(ctx context.Context, req resource.Upda
 		}
 	}
 
+	if ytTabletCellBundlePlan.BundleControllerTargetConfig == nil {
+		attrPath := p.Attr("bundle_controller_target_config")
+		if err := ytsaurus.RemoveIfExists(ctx, r.client, attrPath); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating tablet_cell_bundle",
+				fmt.Sprintf(
+					"Could not remove attribute %q, unexpected error: %q",
+					attrPath.String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
 	if err := r.updateTabletCellCount(ctx, ytTabletCellBundleState.Name, ytTabletCellBundleState.TabletCellCount, ytTabletCellBundlePlan.TabletCellCount); err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating tablet_cell_bundle",
@@ -512,6 +822,12 @@ func (r *tabletCellBundleResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
+func (r *tabletCellBundleResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		tabletCellBundleResourceConfigValidator{},
+	}
+}
+
 func (r *tabletCellBundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,80 @@
+package tabletcellbundle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+type tabletCellBundleResourceConfigValidator struct{}
+
+var _ resource.ConfigValidator = &tabletCellBundleResourceConfigValidator{}
+
+func (v tabletCellBundleResourceConfigValidator) Description(_ context.Context) string {
+	return ""
+}
+
+func (v tabletCellBundleResourceConfigValidator) MarkdownDescription(_ context.Context) string {
+	return ""
+}
+
+func (v tabletCellBundleResourceConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TabletCellBundleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targetConfig := config.BundleControllerTargetConfig
+	if targetConfig == nil || targetConfig.TabletNodeResourceGuarantee == nil {
+		return
+	}
+
+	nodeMemory := targetConfig.TabletNodeResourceGuarantee.Memory
+	if nodeMemory.IsNull() || nodeMemory.IsUnknown() {
+		return
+	}
+
+	if targetConfig.MemoryLimits != nil {
+		var total int64
+		for _, l := range targetConfig.MemoryLimits.values() {
+			if l.IsUnknown() {
+				return
+			}
+			total += l.ValueInt64()
+		}
+		if total > nodeMemory.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("bundle_controller_target_config").AtName("memory_limits"),
+				"Tablet cell bundle configuration error",
+				fmt.Sprintf(
+					"Sum of memory_limits %d exceeds tablet_node_resource_guarantee.memory %d",
+					total,
+					nodeMemory.ValueInt64(),
+				),
+			)
+			return
+		}
+	}
+
+	nodeCount := targetConfig.TabletNodeCount
+	if config.ResourceQuota == nil || nodeCount.IsNull() || nodeCount.IsUnknown() || config.ResourceQuota.Memory.IsUnknown() {
+		return
+	}
+
+	if nodeCount.ValueInt64()*nodeMemory.ValueInt64() > config.ResourceQuota.Memory.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resource_quota").AtName("memory"),
+			"Tablet cell bundle configuration error",
+			fmt.Sprintf(
+				"Memory of %d tablet nodes (%d bytes each) exceeds resource_quota.memory %d",
+				nodeCount.ValueInt64(),
+				nodeMemory.ValueInt64(),
+				config.ResourceQuota.Memory.ValueInt64(),
+			),
+		)
+		return
+	}
+}
@@ -2,18 +2,27 @@ package user
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"regexp"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -25,32 +34,137 @@ import (
 	"terraform-provider-ytsaurus/internal/ytsaurus"
 )
 
+const (
+	defaultRequestRateLimit      = 100
+	defaultRequestQueueSizeLimit = 100
+)
+
 type userResource struct {
-	client yt.Client
+	client *ytsaurus.Client
 }
 
 type UserModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	MemberOf types.Set    `tfsdk:"member_of"`
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	MemberOf              types.Set    `tfsdk:"member_of"`
+	Banned                types.Bool   `tfsdk:"banned"`
+	ReadRequestRateLimit  types.Object `tfsdk:"read_request_rate_limit"`
+	WriteRequestRateLimit types.Object `tfsdk:"write_request_rate_limit"`
+	RequestQueueSizeLimit types.Object `tfsdk:"request_queue_size_limit"`
+	Password              types.String `tfsdk:"password"`
+	PasswordSHA256        types.String `tfsdk:"password_sha256"`
+}
+
+func toPasswordSHA256(u UserModel) string {
+	if !u.Password.IsNull() && !u.Password.IsUnknown() {
+		sum := sha256.Sum256([]byte(u.Password.ValueString()))
+		return hex.EncodeToString(sum[:])
+	}
+	return u.PasswordSHA256.ValueString()
+}
+
+type UserRequestLimitModel struct {
+	Default types.Int64 `tfsdk:"default"`
+	PerCell types.Map   `tfsdk:"per_cell"`
+}
+
+var userRequestLimitAttrTypes = map[string]attr.Type{
+	"default":  types.Int64Type,
+	"per_cell": types.MapType{ElemType: types.Int64Type},
+}
+
+func toUserRequestLimitObject(ctx context.Context, l *ytsaurus.UserRequestLimit) (types.Object, diag.Diagnostics) {
+	if l == nil {
+		return types.ObjectNull(userRequestLimitAttrTypes), nil
+	}
+
+	limit := UserRequestLimitModel{
+		Default: types.Int64Value(l.Default),
+		PerCell: types.MapNull(types.Int64Type),
+	}
+	if len(l.PerCell) > 0 {
+		perCell := make(map[string]attr.Value)
+		for k, v := range l.PerCell {
+			perCell[k] = types.Int64Value(v)
+		}
+		limit.PerCell = types.MapValueMust(types.Int64Type, perCell)
+	}
+	return types.ObjectValueFrom(ctx, userRequestLimitAttrTypes, limit)
+}
+
+func toYTsaurusUserRequestLimit(ctx context.Context, o types.Object) (*ytsaurus.UserRequestLimit, diag.Diagnostics) {
+	if o.IsNull() || o.IsUnknown() {
+		return nil, nil
+	}
+
+	var limit UserRequestLimitModel
+	diags := o.As(ctx, &limit, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ytLimit := ytsaurus.UserRequestLimit{
+		Default: limit.Default.ValueInt64(),
+		PerCell: make(map[string]int64),
+	}
+	if !limit.PerCell.IsNull() {
+		perCell := make(map[string]int64)
+		diags.Append(limit.PerCell.ElementsAs(ctx, &perCell, false)...)
+		ytLimit.PerCell = perCell
+	}
+	return &ytLimit, diags
 }
 
 func toYTsaurusUser(ctx context.Context, u UserModel) (ytsaurus.User, diag.Diagnostics) {
 	var memberOf []string
 	diags := u.MemberOf.ElementsAs(ctx, &memberOf, false)
-	ytMedium := ytsaurus.User{
+	ytUser := ytsaurus.User{
 		Name:     u.Name.ValueString(),
 		MemberOf: &memberOf,
+		Banned:   u.Banned.ValueBool(),
 	}
-	return ytMedium, diags
+
+	var limitDiags diag.Diagnostics
+	ytUser.RequestLimits.ReadRequestRate, limitDiags = toYTsaurusUserRequestLimit(ctx, u.ReadRequestRateLimit)
+	diags.Append(limitDiags...)
+	ytUser.RequestLimits.WriteRequestRate, limitDiags = toYTsaurusUserRequestLimit(ctx, u.WriteRequestRateLimit)
+	diags.Append(limitDiags...)
+	ytUser.RequestLimits.RequestQueueSize, limitDiags = toYTsaurusUserRequestLimit(ctx, u.RequestQueueSizeLimit)
+	diags.Append(limitDiags...)
+
+	return ytUser, diags
 }
 
-func toUserModel(u ytsaurus.User) UserModel {
+func toUserRequestLimitsUpdates(l ytsaurus.UserRequestLimits) map[string]interface{} {
+	updates := make(map[string]interface{})
+	if l.ReadRequestRate != nil {
+		updates["request_limits/read_request_rate"] = l.ReadRequestRate
+	}
+	if l.WriteRequestRate != nil {
+		updates["request_limits/write_request_rate"] = l.WriteRequestRate
+	}
+	if l.RequestQueueSize != nil {
+		updates["request_limits/request_queue_size"] = l.RequestQueueSize
+	}
+	return updates
+}
+
+func toUserModel(ctx context.Context, u ytsaurus.User) (UserModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	user := UserModel{
-		ID:   types.StringValue(u.ID),
-		Name: types.StringValue(u.Name),
+		ID:     types.StringValue(u.ID),
+		Name:   types.StringValue(u.Name),
+		Banned: types.BoolValue(u.Banned),
 	}
 
+	var limitDiags diag.Diagnostics
+	user.ReadRequestRateLimit, limitDiags = toUserRequestLimitObject(ctx, u.RequestLimits.ReadRequestRate)
+	diags.Append(limitDiags...)
+	user.WriteRequestRateLimit, limitDiags = toUserRequestLimitObject(ctx, u.RequestLimits.WriteRequestRate)
+	diags.Append(limitDiags...)
+	user.RequestQueueSizeLimit, limitDiags = toUserRequestLimitObject(ctx, u.RequestLimits.RequestQueueSize)
+	diags.Append(limitDiags...)
+
 	if u.MemberOf != nil && len(*u.MemberOf) > 0 {
 		var memberOf []attr.Value
 		for _, m := range *u.MemberOf {
@@ -60,7 +174,44 @@ func toUserModel(u ytsaurus.User) UserModel {
 	} else {
 		user.MemberOf = types.SetNull(types.StringType)
 	}
-	return user
+	return user, diags
+}
+
+func userRequestLimitSchema(description string, defaultLimit int64) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Computed: true,
+		Default: objectdefault.StaticValue(types.ObjectValueMust(
+			userRequestLimitAttrTypes,
+			map[string]attr.Value{
+				"default":  types.Int64Value(defaultLimit),
+				"per_cell": types.MapNull(types.Int64Type),
+			},
+		)),
+		Attributes: map[string]schema.Attribute{
+			"default": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "Limit applied to every master cell without a per-cell override.",
+			},
+			"per_cell": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[0-9]+$`),
+						"must be a master cell tag",
+					)),
+					mapvalidator.ValueInt64sAre(int64validator.AtLeast(0)),
+				},
+				Description: "Per-cell overrides of the limit, keyed by master cell tag.",
+			},
+		},
+		Description: description + fmt.Sprintf(" Defaults to %d, the cluster default.", defaultLimit),
+	}
 }
 
 var (
@@ -108,6 +259,35 @@ https://ytsaurus.tech/docs/en/user-guide/storage/access-control#users_groups
 				},
 				Description: "A set of user's groups.",
 			},
+			"banned": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Banned users can't make any requests to the cluster.",
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("password_sha256")),
+				},
+				Description: "User's password, only its SHA256 digest is sent to the cluster. The password is never read back, so changes made outside of Terraform are not detected.",
+			},
+			"password_sha256": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[0-9a-f]{64}$`),
+						"must be a lowercase hex SHA256 digest",
+					),
+				},
+				Description: "SHA256 hex digest of the user's password, an alternative to the password attribute.",
+			},
+			"read_request_rate_limit":  userRequestLimitSchema("Read requests per second the user is allowed to make.", defaultRequestRateLimit),
+			"write_request_rate_limit": userRequestLimitSchema("Write requests per second the user is allowed to make.", defaultRequestRateLimit),
+			"request_queue_size_limit": userRequestLimitSchema("Maximum number of the user's requests queued at a master.", defaultRequestQueueSizeLimit),
 		},
 	}
 }
@@ -117,7 +297,31 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	r.client = req.ProviderData.(yt.Client)
+	r.client = req.ProviderData.(*ytsaurus.Client)
+}
+
+func (r *userResource) setPassword(ctx context.Context, user, passwordSHA256 string) error {
+	params := map[string]interface{}{
+		"user":                user,
+		"new_password_sha256": passwordSHA256,
+	}
+	return r.client.ExecuteCommand(ctx, "set_user_password", params, nil)
+}
+
+// removeCreatedUser rolls back a user whose creation failed half way,
+// otherwise the next apply fails on the already existing name.
+func (r *userResource) removeCreatedUser(ctx context.Context, id yt.NodeID, diags *diag.Diagnostics) {
+	p := ypath.Path(fmt.Sprintf("#%s", id.String()))
+	if err := r.client.RemoveNode(ctx, p, nil); err != nil {
+		diags.AddError(
+			"Error removing partially created user",
+			fmt.Sprintf(
+				"Could not remove user %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+	}
 }
 
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -136,6 +340,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	createOptions := &yt.CreateObjectOptions{
 		Attributes: map[string]interface{}{
 			"name":               ytUser.Name,
+			"banned":             ytUser.Banned,
 			"terraform_resource": true,
 		},
 	}
@@ -163,6 +368,40 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 					err.Error(),
 				),
 			)
+			r.removeCreatedUser(ctx, id, &resp.Diagnostics)
+			return
+		}
+	}
+
+	p := ypath.Path(fmt.Sprintf("#%s", id.String()))
+	for k, v := range toUserRequestLimitsUpdates(ytUser.RequestLimits) {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating user attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			r.removeCreatedUser(ctx, id, &resp.Diagnostics)
+			return
+		}
+	}
+
+	if passwordSHA256 := toPasswordSHA256(plan); passwordSHA256 != "" {
+		if err := r.setPassword(ctx, ytUser.Name, passwordSHA256); err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting user password",
+				fmt.Sprintf(
+					"Could not set password of user %q, unexpected error: %q",
+					ytUser.Name,
+					err.Error(),
+				),
+			)
+			r.removeCreatedUser(ctx, id, &resp.Diagnostics)
+			return
 		}
 	}
 
@@ -204,7 +443,14 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		ytUser.MemberOf = &memberOfWithoutBuiltin
 	}
 
-	state = toUserModel(ytUser)
+	password, passwordSHA256 := state.Password, state.PasswordSHA256
+	state, diags := toUserModel(ctx, ytUser)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The password can't be read back, so the configured value is kept.
+	state.Password, state.PasswordSHA256 = password, passwordSHA256
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -249,6 +495,39 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
+	p := ypath.Path(fmt.Sprintf("#%s", state.ID.ValueString()))
+	attributeUpdates := toUserRequestLimitsUpdates(ytUserPlan.RequestLimits)
+	attributeUpdates["banned"] = ytUserPlan.Banned
+	for k, v := range attributeUpdates {
+		if err := r.client.SetNode(ctx, p.Attr(k), v, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating user attributes",
+				fmt.Sprintf(
+					"Could not set node %q to '%v', unexpected error: %q",
+					p.Attr(k).String(),
+					v,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
+	planPasswordSHA256 := toPasswordSHA256(plan)
+	if planPasswordSHA256 != "" && planPasswordSHA256 != toPasswordSHA256(state) {
+		if err := r.setPassword(ctx, ytUserPlan.Name, planPasswordSHA256); err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting user password",
+				fmt.Sprintf(
+					"Could not set password of user %q, unexpected error: %q",
+					ytUserPlan.Name,
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+
 	stateMemberOfSet := set.ToStringSet(*ytUserState.MemberOf)
 	planMemberOfSet := set.ToStringSet(*ytUserPlan.MemberOf)
 
@@ -0,0 +1,271 @@
+package usertoken
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+
+	"terraform-provider-ytsaurus/internal/ytsaurus"
+)
+
+type userTokenResource struct {
+	client *ytsaurus.Client
+}
+
+type UserTokenModel struct {
+	ID          types.String `tfsdk:"id"`
+	User        types.String `tfsdk:"user"`
+	Description types.String `tfsdk:"description"`
+	Rotation    types.Map    `tfsdk:"rotation"`
+	Token       types.String `tfsdk:"token"`
+	TokenSHA256 types.String `tfsdk:"token_sha256"`
+}
+
+// userTokenResource intentionally doesn't implement resource.ResourceWithImportState:
+// the cluster keeps only the token hash, so an imported token could never be read back.
+var (
+	_ resource.Resource              = &userTokenResource{}
+	_ resource.ResourceWithConfigure = &userTokenResource{}
+)
+
+func NewUserTokenResource() resource.Resource {
+	return &userTokenResource{}
+}
+
+func tokenPath(tokenSHA256 string) ypath.Path {
+	return ypath.Path(fmt.Sprintf("//sys/cypress_tokens/%s", tokenSHA256))
+}
+
+func (r *userTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_token"
+}
+
+func (r *userTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*ytsaurus.Client)
+}
+
+func (r *userTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+An API token issued for a user, e.g. for a robot user. The token is revoked on destroy.
+The token is stored in the state as a sensitive value, token_sha256 can be used to audit state changes.
+Change the rotation map to issue a new token and revoke the previous one.
+
+	Attention!
+	The resource can't be imported since the token value is never returned by the cluster again.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Equals to token_sha256.",
+			},
+			"user": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "YTsaurus user name the token is issued for.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Free-form token description, stored in the token's @description attribute.",
+			},
+			"rotation": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary map of values, any change of it issues a new token.",
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The issued token.",
+			},
+			"token_sha256": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "SHA256 hex digest of the issued token.",
+			},
+		},
+	}
+}
+
+func (r *userTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var issuedToken ytsaurus.IssuedToken
+	params := map[string]interface{}{
+		"user": plan.User.ValueString(),
+	}
+	if err := r.client.ExecuteCommand(ctx, "issue_token", params, &issuedToken); err != nil {
+		resp.Diagnostics.AddError(
+			"Error issuing token",
+			fmt.Sprintf(
+				"Could not issue token for user %q, unexpected error: %q",
+				plan.User.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	sum := sha256.Sum256([]byte(issuedToken.Token))
+	tokenSHA256 := hex.EncodeToString(sum[:])
+	plan.ID = types.StringValue(tokenSHA256)
+	plan.Token = types.StringValue(issuedToken.Token)
+	plan.TokenSHA256 = types.StringValue(tokenSHA256)
+
+	// The token is saved even if the description can't be set, so it could be revoked on destroy.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	if !plan.Description.IsNull() {
+		p := tokenPath(tokenSHA256).Attr("description")
+		if err := r.client.SetNode(ctx, p, plan.Description.ValueString(), nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating token description",
+				fmt.Sprintf(
+					"Could not set node %q, unexpected error: %q",
+					p.String(),
+					err.Error(),
+				),
+			)
+			return
+		}
+	}
+}
+
+func (r *userTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserTokenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := tokenPath(state.TokenSHA256.ValueString())
+	ok, err := r.client.NodeExists(ctx, p, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading token",
+			fmt.Sprintf(
+				"Could not check token %q existence, unexpected error: %q",
+				state.TokenSHA256.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if !ok {
+		// The token was revoked outside of Terraform, the plan will issue a new one.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var cypressToken ytsaurus.CypressToken
+	if err := r.client.GetNode(ctx, p.Attrs(), &cypressToken, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading token",
+			fmt.Sprintf(
+				"Could not read token %q attributes, unexpected error: %q",
+				state.TokenSHA256.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	state.User = types.StringValue(cypressToken.User)
+	if cypressToken.Description != "" {
+		state.Description = types.StringValue(cypressToken.Description)
+	} else {
+		state.Description = types.StringNull()
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *userTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := tokenPath(plan.TokenSHA256.ValueString()).Attr("description")
+	var err error
+	if plan.Description.IsNull() {
+		err = r.client.RemoveNode(ctx, p, &yt.RemoveNodeOptions{Force: true})
+	} else {
+		err = r.client.SetNode(ctx, p, plan.Description.ValueString(), nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating token description",
+			fmt.Sprintf(
+				"Could not update node %q, unexpected error: %q",
+				p.String(),
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *userTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserTokenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{
+		"user":         state.User.ValueString(),
+		"token_sha256": state.TokenSHA256.ValueString(),
+	}
+	if err := r.client.ExecuteCommand(ctx, "revoke_token", params, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error revoking token",
+			fmt.Sprintf(
+				"Could not revoke token %q of user %q, unexpected error: %q",
+				state.TokenSHA256.ValueString(),
+				state.User.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+}
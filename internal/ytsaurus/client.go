@@ -0,0 +1,166 @@
+package ytsaurus
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.ytsaurus.tech/yt/go/yson"
+	"go.ytsaurus.tech/yt/go/yt"
+	"go.ytsaurus.tech/yt/go/yt/ythttp"
+	"go.ytsaurus.tech/yt/go/yterrors"
+)
+
+// ClientConfig holds the cluster connection settings of the provider.
+type ClientConfig struct {
+	Proxy         string
+	Token         string
+	UseTLS        bool
+	CACertificate string
+}
+
+// YTConfig converts the settings into the go client config. The token falls back to
+// YT_TOKEN and then to the token file, the same way the yt CLI does.
+func (c ClientConfig) YTConfig() *yt.Config {
+	config := &yt.Config{
+		Proxy:             c.Proxy,
+		Token:             c.Token,
+		UseTLS:            c.UseTLS,
+		ReadTokenFromFile: c.Token == "",
+	}
+	if c.CACertificate != "" {
+		config.CertificateAuthorityData = []byte(c.CACertificate)
+	}
+	return config
+}
+
+// Client extends yt.Client with commands which are not yet supported by the go client library.
+// Both are built from the same yt.Config, so commands go to the same proxy with the same
+// credentials and trusted certificates as the rest of the requests.
+type Client struct {
+	yt.Client
+
+	clusterURL  yt.ClusterURL
+	schema      string
+	credentials yt.Credentials
+	httpClient  *http.Client
+}
+
+func NewClient(c *yt.Config) (*Client, error) {
+	ytClient, err := ythttp.NewClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy, err := c.GetProxy()
+	if err != nil {
+		return nil, err
+	}
+
+	certPool, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, err
+	}
+	if len(c.CertificateAuthorityData) > 0 {
+		if ok := certPool.AppendCertsFromPEM(c.CertificateAuthorityData); !ok {
+			return nil, errors.New("invalid PEM encoded certificate")
+		}
+	}
+
+	client := &Client{
+		Client:     ytClient,
+		clusterURL: yt.NormalizeProxyURL(proxy, c.DisableProxyDiscovery, c.UseTVMOnlyEndpoint, tvmOnlyPort(c)),
+		schema:     "http",
+		httpClient: &http.Client{
+			Timeout: c.GetLightRequestTimeout(),
+			Transport: &http.Transport{
+				IdleConnTimeout:     30 * time.Second,
+				TLSHandshakeTimeout: 10 * time.Second,
+				TLSClientConfig: &tls.Config{
+					RootCAs: certPool,
+				},
+			},
+		},
+	}
+	if c.UseTLS {
+		client.schema = "https"
+	}
+	if c.Credentials != nil {
+		client.credentials = c.Credentials
+	} else if token := c.GetToken(); token != "" {
+		client.credentials = &yt.TokenCredentials{Token: token}
+	}
+
+	return client, nil
+}
+
+func tvmOnlyPort(c *yt.Config) int {
+	if c.UseTLS {
+		return yt.TVMOnlyHTTPSProxyPort
+	}
+	return yt.TVMOnlyHTTPProxyPort
+}
+
+// ExecuteCommand runs a light HTTP API command and unmarshals its output into result, if not nil.
+func (c *Client) ExecuteCommand(ctx context.Context, command string, params map[string]interface{}, result interface{}) error {
+	encodedParams, err := yson.MarshalFormat(params, yson.FormatText)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s://%s/api/v4/%s", c.schema, c.clusterURL.Address, command)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-YT-Header-Format", "<format=text>yson")
+	req.Header.Set("X-YT-Output-Format", "yson")
+	req.Header.Set("X-YT-Parameters", string(encodedParams))
+	req.Header.Set("User-Agent", "terraform-provider-ytsaurus")
+	if credentials := yt.ContextCredentials(ctx); credentials != nil {
+		credentials.Set(req)
+	} else if c.credentials != nil {
+		c.credentials.Set(req)
+	}
+
+	rsp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rsp.Body.Close() }()
+
+	if header := rsp.Header.Get("X-YT-Error"); header != "" {
+		var ytErr yterrors.Error
+		if err := json.Unmarshal([]byte(header), &ytErr); err != nil {
+			return fmt.Errorf("malformed 'X-YT-Error' header: %w", err)
+		}
+		return &ytErr
+	}
+
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+
+	if rsp.StatusCode/100 != 2 {
+		var ytErr yterrors.Error
+		d := json.NewDecoder(bytes.NewReader(body))
+		d.UseNumber()
+		if err := d.Decode(&ytErr); err == nil {
+			return &ytErr
+		}
+		return fmt.Errorf("command %q failed with unexpected status code %d", command, rsp.StatusCode)
+	}
+
+	if result == nil || len(body) == 0 {
+		return nil
+	}
+	return yson.Unmarshal(body, result)
+}
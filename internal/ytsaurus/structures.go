@@ -1,26 +1,49 @@
 package ytsaurus
 
 import (
+	"go.ytsaurus.tech/yt/go/schema"
 	"go.ytsaurus.tech/yt/go/yt"
 )
 
 type Group struct {
-	ID   string `yson:"id"`
-	Name string `yson:"name"`
+	ID      string    `yson:"id"`
+	Name    string    `yson:"name"`
+	Members *[]string `yson:"members"`
+}
+
+type UserRequestLimit struct {
+	Default int64            `yson:"default"`
+	PerCell map[string]int64 `yson:"per_cell"`
+}
+
+type UserRequestLimits struct {
+	ReadRequestRate  *UserRequestLimit `yson:"read_request_rate"`
+	WriteRequestRate *UserRequestLimit `yson:"write_request_rate"`
+	RequestQueueSize *UserRequestLimit `yson:"request_queue_size"`
 }
 
 type User struct {
-	ID       string    `yson:"id"`
-	Name     string    `yson:"name"`
-	MemberOf *[]string `yson:"member_of"`
+	ID            string            `yson:"id"`
+	Name          string            `yson:"name"`
+	MemberOf      *[]string         `yson:"member_of"`
+	Banned        bool              `yson:"banned"`
+	RequestLimits UserRequestLimits `yson:"request_limits"`
+}
+
+type AccountMasterMemoryLimits struct {
+	Total     int64            `yson:"total"`
+	ChunkHost *int64           `yson:"chunk_host,omitempty"`
+	PerCell   map[string]int64 `yson:"per_cell,omitempty"`
 }
 
 type AccountResourceLimits struct {
-	NodeCount          int64            `yson:"node_count"`
-	ChunkCount         int64            `yson:"chunk_count"`
-	TabletCount        int64            `yson:"tablet_count"`
-	TabletStaticMemory int64            `yson:"tablet_static_memory"`
-	DiskSpacePerMedium map[string]int64 `yson:"disk_space_per_medium"`
+	NodeCount                 int64                      `yson:"node_count"`
+	ChunkCount                int64                      `yson:"chunk_count"`
+	TabletCount               int64                      `yson:"tablet_count"`
+	TabletStaticMemory        int64                      `yson:"tablet_static_memory"`
+	DiskSpacePerMedium        map[string]int64           `yson:"disk_space_per_medium"`
+	MasterMemory              *AccountMasterMemoryLimits `yson:"master_memory,omitempty"`
+	ChunkHostCellMasterMemory map[string]int64           `yson:"chunk_host_cell_master_memory,omitempty"`
 }
 
 type Account struct {
@@ -57,6 +80,98 @@ type MapNode struct {
 	ACL        []yt.ACE `yson:"acl"`
 }
 
+type Portal struct {
+	ID          string   `yson:"id"`
+	Path        string   `yson:"path"`
+	ExitCellTag int64    `yson:"exit_cell_tag"`
+	ExitNodeID  string   `yson:"exit_node_id"`
+	Account     string   `yson:"-"`
+	InheritACL  bool     `yson:"-"`
+	ACL         []yt.ACE `yson:"-"`
+}
+
+type Document struct {
+	ID         string      `yson:"id"`
+	Path       string      `yson:"path"`
+	Value      interface{} `yson:"-"`
+	Account    string      `yson:"account"`
+	InheritACL bool        `yson:"inherit_acl"`
+	ACL        []yt.ACE    `yson:"acl"`
+}
+
+type Link struct {
+	ID         string `yson:"id"`
+	Path       string `yson:"path"`
+	TargetPath string `yson:"target_path"`
+	Broken     bool   `yson:"broken"`
+}
+
+type File struct {
+	ID                string `yson:"id"`
+	Path              string `yson:"path"`
+	MD5               string `yson:"md5"`
+	Executable        bool   `yson:"executable"`
+	PrimaryMedium     string `yson:"primary_medium"`
+	ReplicationFactor int64  `yson:"replication_factor"`
+	Account           string `yson:"account"`
+}
+
+type Table struct {
+	ID                string         `yson:"id"`
+	Path              string         `yson:"path"`
+	Schema            *schema.Schema `yson:"schema"`
+	CompressionCodec  string         `yson:"compression_codec"`
+	ErasureCodec      string         `yson:"erasure_codec"`
+	OptimizeFor       string         `yson:"optimize_for"`
+	PrimaryMedium     string         `yson:"primary_medium"`
+	ReplicationFactor int64          `yson:"replication_factor"`
+	Account           string         `yson:"account"`
+	InheritACL        bool           `yson:"inherit_acl"`
+	ACL               []yt.ACE       `yson:"acl"`
+}
+
+type DynamicTable struct {
+	ID               string         `yson:"id"`
+	Path             string         `yson:"path"`
+	Schema           *schema.Schema `yson:"schema"`
+	TabletCellBundle string         `yson:"tablet_cell_bundle"`
+	TabletCount      int64          `yson:"tablet_count"`
+	PivotKeys        []interface{}  `yson:"pivot_keys"`
+	InMemoryMode     string         `yson:"in_memory_mode"`
+	TabletState      string         `yson:"tablet_state"`
+	Account          string         `yson:"account"`
+	InheritACL       bool           `yson:"inherit_acl"`
+	ACL              []yt.ACE       `yson:"acl"`
+}
+
+type ReplicatedTableOptions struct {
+	EnableReplicatedTableTracker *bool  `yson:"enable_replicated_table_tracker,omitempty"`
+	MinSyncReplicaCount          *int64 `yson:"min_sync_replica_count,omitempty"`
+	MaxSyncReplicaCount          *int64 `yson:"max_sync_replica_count,omitempty"`
+}
+
+type ReplicatedTable struct {
+	ID                     string                  `yson:"id"`
+	Path                   string                  `yson:"path"`
+	Schema                 *schema.Schema          `yson:"schema"`
+	TabletCellBundle       string                  `yson:"tablet_cell_bundle"`
+	TabletState            string                  `yson:"tablet_state"`
+	ReplicatedTableOptions *ReplicatedTableOptions `yson:"replicated_table_options"`
+	Account                string                  `yson:"account"`
+	InheritACL             bool                    `yson:"inherit_acl"`
+	ACL                    []yt.ACE                `yson:"acl"`
+}
+
+type TableReplica struct {
+	ID                 string `yson:"id"`
+	TablePath          string `yson:"table_path"`
+	ClusterName        string `yson:"cluster_name"`
+	ReplicaPath        string `yson:"replica_path"`
+	Mode               string `yson:"mode"`
+	State              string `yson:"state"`
+	PreserveTimestamps bool   `yson:"preserve_timestamps"`
+}
+
 type TabletCellBundleOptions struct {
 	ChangelogAccount           string `yson:"changelog_account"`
 	ChangelogWriteQuorum       int64  `yson:"changelog_write_quorum"`
@@ -68,13 +183,50 @@ type TabletCellBundleOptions struct {
 	SnapshotPrimaryMedium      string `yson:"snapshot_primary_medium"`
 }
 
+type TabletCellBundleResourceQuota struct {
+	CPU    int64 `yson:"cpu"`
+	Memory int64 `yson:"memory"`
+}
+
+type TabletCellBundleInstanceResources struct {
+	VCPU   *int64 `yson:"vcpu,omitempty"`
+	Memory *int64 `yson:"memory,omitempty"`
+	Type   string `yson:"type,omitempty"`
+}
+
+type TabletCellBundleMemoryLimits struct {
+	TabletStatic           *int64 `yson:"tablet_static,omitempty"`
+	TabletDynamic          *int64 `yson:"tablet_dynamic,omitempty"`
+	CompressedBlockCache   *int64 `yson:"compressed_block_cache,omitempty"`
+	UncompressedBlockCache *int64 `yson:"uncompressed_block_cache,omitempty"`
+	VersionedChunkMeta     *int64 `yson:"versioned_chunk_meta,omitempty"`
+	LookupRowCache         *int64 `yson:"lookup_row_cache,omitempty"`
+	Reserved               *int64 `yson:"reserved,omitempty"`
+}
+
+type TabletCellBundleCPULimits struct {
+	LookupThreadPoolSize *int64 `yson:"lookup_thread_pool_size,omitempty"`
+	QueryThreadPoolSize  *int64 `yson:"query_thread_pool_size,omitempty"`
+	WriteThreadPoolSize  *int64 `yson:"write_thread_pool_size,omitempty"`
+}
+
+type TabletCellBundleTargetConfig struct {
+	TabletNodeCount             *int64                             `yson:"tablet_node_count,omitempty"`
+	RPCProxyCount               *int64                             `yson:"rpc_proxy_count,omitempty"`
+	TabletNodeResourceGuarantee *TabletCellBundleInstanceResources `yson:"tablet_node_resource_guarantee,omitempty"`
+	MemoryLimits                *TabletCellBundleMemoryLimits      `yson:"memory_limits,omitempty"`
+	CPULimits                   *TabletCellBundleCPULimits         `yson:"cpu_limits,omitempty"`
+}
+
 type TabletCellBundle struct {
-	ID              string                   `yson:"id"`
-	Name            string                   `yson:"name"`
-	NodeTagFilter   string                   `yson:"node_tag_filter"`
-	TabletCellCount int64                    `yson:"tablet_cell_count"`
-	ACL             []yt.ACE                 `yson:"acl"`
-	Options         *TabletCellBundleOptions `yson:"options"`
+	ID                           string                         `yson:"id"`
+	Name                         string                         `yson:"name"`
+	NodeTagFilter                string                         `yson:"node_tag_filter"`
+	TabletCellCount              int64                          `yson:"tablet_cell_count"`
+	ACL                          []yt.ACE                       `yson:"acl"`
+	Options                      *TabletCellBundleOptions       `yson:"options"`
+	ResourceQuota                *TabletCellBundleResourceQuota `yson:"resource_quota"`
+	BundleControllerTargetConfig *TabletCellBundleTargetConfig  `yson:"bundle_controller_target_config"`
 }
 
 type TabletCellBundleArea struct {
@@ -111,3 +263,138 @@ type SchedulerPool struct {
 	Mode                      *string                          `yson:"mode"`
 	ForbidImmediateOperations *bool                            `yson:"forbid_immediate_operations"`
 }
+
+type SchedulerPoolTreeConfig struct {
+	NodesFilter                     *string `yson:"nodes_filter"`
+	DefaultParentPool               *string `yson:"default_parent_pool"`
+	FairShareStarvationTimeout      *int64  `yson:"fair_share_starvation_timeout"`
+	FairShareStarvationTimeoutLimit *int64  `yson:"fair_share_starvation_timeout_limit"`
+	MainResource                    *string `yson:"main_resource"`
+	MaxEphemeralPoolsPerUser        *int64  `yson:"max_ephemeral_pools_per_user"`
+}
+
+type SchedulerPoolTree struct {
+	ID     string                   `yson:"id"`
+	Name   string                   `yson:"name"`
+	Config *SchedulerPoolTreeConfig `yson:"config"`
+	ACL    []yt.ACE                 `yson:"acl"`
+}
+
+type AccessControlObjectNamespace struct {
+	ID   string   `yson:"id"`
+	Name string   `yson:"name"`
+	ACL  []yt.ACE `yson:"acl"`
+}
+
+type AccessControlObject struct {
+	ID           string   `yson:"id"`
+	Name         string   `yson:"name"`
+	Namespace    string   `yson:"namespace"`
+	ACL          []yt.ACE `yson:"acl"`
+	PrincipalACL []yt.ACE `yson:"principal_acl"`
+}
+
+type IssuedToken struct {
+	Token string `yson:"token"`
+}
+
+type CypressToken struct {
+	User        string `yson:"user"`
+	Description string `yson:"description"`
+}
+
+type QueueConsumerRegistration struct {
+	Vital      bool     `yson:"vital"`
+	Partitions *[]int64 `yson:"partitions"`
+}
+
+type QueueAutoTrimConfig struct {
+	Enable                   bool   `yson:"enable"`
+	RetainedRows             *int64 `yson:"retained_rows,omitempty"`
+	RetainedLifetimeDuration *int64 `yson:"retained_lifetime_duration,omitempty"`
+}
+
+type QueueStaticExportConfig struct {
+	ExportDirectory            string  `yson:"export_directory"`
+	ExportPeriod               int64   `yson:"export_period"`
+	OutputTableNamePattern     *string `yson:"output_table_name_pattern,omitempty"`
+	UseUpperBoundForTableNames bool    `yson:"use_upper_bound_for_table_names"`
+}
+
+type QueueStatus struct {
+	PartitionCount int64 `yson:"partition_count"`
+}
+
+type QueuePartition struct {
+	LowerRowIndex     int64 `yson:"lower_row_index"`
+	UpperRowIndex     int64 `yson:"upper_row_index"`
+	AvailableRowCount int64 `yson:"available_row_count"`
+	CommitIdleTime    int64 `yson:"commit_idle_time"`
+}
+
+type Queue struct {
+	ID                 string                             `yson:"id"`
+	Path               string                             `yson:"path"`
+	Schema             *schema.Schema                     `yson:"schema"`
+	TabletCellBundle   string                             `yson:"tablet_cell_bundle"`
+	TabletCount        int64                              `yson:"tablet_count"`
+	TabletState        string                             `yson:"tablet_state"`
+	AutoTrimConfig     *QueueAutoTrimConfig               `yson:"auto_trim_config"`
+	StaticExportConfig map[string]QueueStaticExportConfig `yson:"static_export_config"`
+	QueueAgentStage    string                             `yson:"queue_agent_stage"`
+	Account            string                             `yson:"account"`
+	InheritACL         bool                               `yson:"inherit_acl"`
+	ACL                []yt.ACE                           `yson:"acl"`
+}
+
+type ChytClique struct {
+	ID           string                 `yson:"id"`
+	Alias        string                 `yson:"-"`
+	Speclet      map[string]interface{} `yson:"-"`
+	PrincipalACL []yt.ACE               `yson:"-"`
+}
+
+type CypressAttribute struct {
+	Path  string      `yson:"-"`
+	Name  string      `yson:"-"`
+	Value interface{} `yson:"-"`
+}
+
+type ClusterNode struct {
+	Address              string   `yson:"-"`
+	UserTags             []string `yson:"user_tags"`
+	Banned               bool     `yson:"banned"`
+	Decommissioned       bool     `yson:"decommissioned"`
+	DisableSchedulerJobs bool     `yson:"disable_scheduler_jobs"`
+	DisableWriteSessions bool     `yson:"disable_write_sessions"`
+}
+
+type DataCenter struct {
+	ID   string `yson:"id"`
+	Name string `yson:"name"`
+}
+
+type Rack struct {
+	ID         string `yson:"id"`
+	Name       string `yson:"name"`
+	DataCenter string `yson:"data_center"`
+}
+
+type HostRack struct {
+	Name string `yson:"name"`
+	Rack string `yson:"rack"`
+}
+
+type NetworkProject struct {
+	ID        string   `yson:"id"`
+	Name      string   `yson:"name"`
+	ProjectID int64    `yson:"project_id"`
+	ACL       []yt.ACE `yson:"acl"`
+}
+
+type ProxyRole struct {
+	ID        string   `yson:"id"`
+	Name      string   `yson:"name"`
+	ProxyKind string   `yson:"proxy_kind"`
+	ACL       []yt.ACE `yson:"acl"`
+}
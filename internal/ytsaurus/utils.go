@@ -2,12 +2,18 @@ package ytsaurus
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"go.ytsaurus.tech/yt/go/ypath"
 	"go.ytsaurus.tech/yt/go/yt"
 )
 
+const (
+	TabletStateTimeout = 10 * time.Minute
+)
+
 func GetObjectByID(ctx context.Context, client yt.Client, id string, resp interface{}) error {
 	p := ypath.Path(fmt.Sprintf("#%s/@", id))
 	return client.GetNode(ctx, p, resp, nil)
@@ -23,3 +29,81 @@ func RemoveIfExists(ctx context.Context, client yt.Client, p ypath.Path) error {
 	}
 	return client.RemoveNode(ctx, p, nil)
 }
+
+func WaitForTabletState(ctx context.Context, client yt.Client, p ypath.Path, state string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var current string
+	err := yt.PollMaster(ctx, client, func() (bool, error) {
+		if err := client.GetNode(ctx, p.Attr("tablet_state"), &current, nil); err != nil {
+			return false, err
+		}
+		return current == state, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%q tablet_state is %q, expected %q after %s", p.String(), current, state, timeout)
+	}
+	return err
+}
+
+// SetTabletState moves the table from current to expected tablet state. With remount set, tablets that stay mounted
+// or frozen are remounted to pick up changed settings, frozen tablets are unfrozen for that first.
+func SetTabletState(ctx context.Context, client yt.Client, p ypath.Path, current, expected string, remount bool) error {
+	if remount && expected != yt.TabletUnmounted {
+		if current == yt.TabletFrozen {
+			if err := client.UnfreezeTable(ctx, p, nil); err != nil {
+				return err
+			}
+			if err := WaitForTabletState(ctx, client, p, yt.TabletMounted, TabletStateTimeout); err != nil {
+				return err
+			}
+			current = yt.TabletMounted
+		}
+		if current == yt.TabletMounted {
+			if err := client.RemountTable(ctx, p, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch expected {
+	case yt.TabletMounted:
+		switch current {
+		case yt.TabletMounted:
+			return nil
+		case yt.TabletFrozen:
+			if err := client.UnfreezeTable(ctx, p, nil); err != nil {
+				return err
+			}
+		default:
+			if err := client.MountTable(ctx, p, nil); err != nil {
+				return err
+			}
+		}
+	case yt.TabletFrozen:
+		switch current {
+		case yt.TabletFrozen:
+			return nil
+		case yt.TabletMounted:
+			if err := client.FreezeTable(ctx, p, nil); err != nil {
+				return err
+			}
+		default:
+			if err := client.MountTable(ctx, p, &yt.MountTableOptions{Freeze: true}); err != nil {
+				return err
+			}
+		}
+	case yt.TabletUnmounted:
+		if current == yt.TabletUnmounted {
+			return nil
+		}
+		if err := client.UnmountTable(ctx, p, nil); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported tablet state %q", expected)
+	}
+
+	return WaitForTabletState(ctx, client, p, expected, TabletStateTimeout)
+}
@@ -0,0 +1,76 @@
+package ytsaurus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"go.ytsaurus.tech/yt/go/yson"
+)
+
+func UnmarshalValue(s string) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewBufferString(s))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err == nil && !d.More() {
+		return fromJSONNumbers(v), nil
+	}
+
+	v = nil
+	if err := yson.Unmarshal([]byte(s), &v); err != nil {
+		return nil, fmt.Errorf("value is neither a valid JSON nor a valid YSON: %w", err)
+	}
+	return v, nil
+}
+
+func MarshalValue(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func NormalizeValue(s string) (string, error) {
+	v, err := UnmarshalValue(s)
+	if err != nil {
+		return "", err
+	}
+	return MarshalValue(v)
+}
+
+func IsEqualValues(a, b string) bool {
+	na, err := NormalizeValue(a)
+	if err != nil {
+		return false
+	}
+	nb, err := NormalizeValue(b)
+	if err != nil {
+		return false
+	}
+	return na == nb
+}
+
+func fromJSONNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = fromJSONNumbers(e)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = fromJSONNumbers(e)
+		}
+		return v
+	default:
+		return v
+	}
+}